@@ -0,0 +1,49 @@
+package tinytranslator
+
+import "strings"
+
+// ctxTag is the struct tag holding the context of an entry, eg: `ctx:"char"`
+const ctxTag = "ctx"
+
+// keyTag is the struct tag overriding the key derived from the field name, eg: `key:"api_response"`
+const keyTag = "key"
+
+// ctxSeparator joins a context and a key, following the gettext convention
+// for msgctxt in compiled catalogs
+const ctxSeparator = "\x04"
+
+// Ctx returns the key of an entry qualified by a context, so that one English
+// word with several meanings can have a translation for each one. Keys without
+// an entry for that context use the entry without context.
+//
+// Dictionary fields with a `ctx` tag are qualified by it, their key and English
+// value drop the context suffix of the field name:
+//
+//	Space     string `es:"espacio" ru:"пространство"`
+//	SpaceChar string `ctx:"char" es:"espacio" ru:"пробел"`
+//
+// Example usage:
+//
+//	translator.T("ru", D.SpaceChar)             // "пробел"
+//	translator.T("ru", Ctx("char", D.Space))    // "пробел"
+//	translator.T("ru", Ctx("unknown", D.Space)) // "пространство"
+func Ctx(context, key string) string {
+	if context == "" {
+		return key
+	}
+	return context + ctxSeparator + key
+}
+
+// SplitCtx returns the context and key of a key qualified by Ctx, the context
+// is empty for keys without one.
+//
+// Example usage:
+//
+//	SplitCtx(D.SpaceChar) // "char", "space"
+//	SplitCtx(D.Space)     // "", "space"
+func SplitCtx(key string) (context, plain string) {
+	if context, plain, found := strings.Cut(key, ctxSeparator); found {
+		return context, plain
+	}
+	return "", key
+}
@@ -0,0 +1,37 @@
+package tinytranslator
+
+import "testing"
+
+func TestContextEntries(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	if D.SpaceChar != Ctx("char", "space") {
+		t.Fatalf("D.SpaceChar = %q; want key qualified by its context", D.SpaceChar)
+	}
+
+	tests := []struct {
+		name string
+		args []any
+		want string
+	}{
+		{"field with context", []any{"ru", D.SpaceChar}, "пробел"},
+		{"field without context", []any{"ru", D.Space}, "пространство"},
+		{"lookup with context", []any{"de", Ctx("char", D.Space)}, "Leerzeichen"},
+		{"english drops the context", []any{"en", D.SpaceChar}, "space"},
+		{"unknown context falls back", []any{"ru", Ctx("area", D.Space)}, "пространство"},
+		{"empty context", []any{"ru", Ctx("", D.Space)}, "пространство"},
+		{"unknown key keeps text", []any{"es", Ctx("char", "tab")}, "tab"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.T(tt.args...); got != tt.want {
+			t.Errorf("%s: T(%q) = %q; want %q", tt.name, tt.args, got, tt.want)
+		}
+	}
+
+	for _, code := range translator.Languages() {
+		if code == ctxTag {
+			t.Errorf("ctx tag listed as a language")
+		}
+	}
+}
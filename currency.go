@@ -0,0 +1,208 @@
+package tinytranslator
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// currency describes an ISO 4217 currency
+type currency struct {
+	symbol string // symbol used in any language, eg: "US$"
+	narrow string // symbol used in languages where the currency is local, eg: "$"
+	digits int    // digits of the minor unit, eg: 2 for cents
+}
+
+// currencies lists the ISO 4217 currencies with known symbols
+var currencies = map[string]currency{
+	"USD": {"US$", "$", 2},
+	"EUR": {"€", "€", 2},
+	"GBP": {"£", "£", 2},
+	"JPY": {"¥", "¥", 0},
+	"CNY": {"CN¥", "¥", 2},
+	"INR": {"₹", "₹", 2},
+	"BRL": {"R$", "R$", 2},
+	"RUB": {"RUB", "₽", 2},
+	"MXN": {"MX$", "$", 2},
+	"CAD": {"CA$", "$", 2},
+	"AUD": {"A$", "$", 2},
+	"CHF": {"CHF", "CHF", 2},
+	"CLP": {"CLP", "$", 0},
+	"ARS": {"ARS", "$", 2},
+	"COP": {"COP", "$", 2},
+	"IDR": {"IDR", "Rp", 2},
+	"BDT": {"BDT", "৳", 2},
+	"PKR": {"PKR", "Rs", 2},
+	"SAR": {"SAR", "ر.س.\u200f", 2},
+	"AED": {"AED", "د.إ.\u200f", 2},
+	"EGP": {"EGP", "ج.م.\u200f", 2},
+	"KWD": {"KWD", "د.ك.\u200f", 3},
+	"BHD": {"BHD", "د.ب.\u200f", 3},
+	"KRW": {"₩", "₩", 0},
+}
+
+// CurrencyFormat defines how money amounts are written in a language
+type CurrencyFormat struct {
+	SymbolBefore bool     // symbol before the amount, eg: "$1.00", or after, eg: "1,00 €"
+	Space        string   // written between the symbol and the amount
+	Local        []string // currency codes written with their narrow symbol, eg: "$" for USD in en
+}
+
+// DefaultCurrencyFormat returns the built-in currency format of a language code.
+// Languages without specific conventions use the english ones.
+func DefaultCurrencyFormat(code string) CurrencyFormat {
+	switch code {
+	case "es", "fr", "de", "it":
+		return CurrencyFormat{Space: nbsp, Local: []string{"EUR"}}
+	case "pt":
+		return CurrencyFormat{SymbolBefore: true, Space: nbsp, Local: []string{"BRL"}}
+	case "ru":
+		return CurrencyFormat{Space: nbsp, Local: []string{"RUB"}}
+	case "hi":
+		return CurrencyFormat{SymbolBefore: true, Local: []string{"INR"}}
+	case "bn":
+		return CurrencyFormat{Local: []string{"BDT"}}
+	case "id":
+		return CurrencyFormat{SymbolBefore: true, Local: []string{"IDR"}}
+	case "ar":
+		return CurrencyFormat{Space: nbsp, Local: []string{"SAR", "AED", "EGP", "KWD", "BHD"}}
+	case "ur":
+		return CurrencyFormat{SymbolBefore: true, Local: []string{"PKR"}}
+	case "zh":
+		return CurrencyFormat{SymbolBefore: true, Local: []string{"CNY"}}
+	}
+	return CurrencyFormat{SymbolBefore: true, Local: []string{"USD"}}
+}
+
+// WithCurrencyFormat overrides the currency format used for a language.
+// Unsupported language codes are ignored.
+func (l *Translator) WithCurrencyFormat(code string, cf CurrencyFormat) *Translator {
+	if i := l.findLanguageIndex(code); i >= 0 {
+		l.langSupported[i].currency = cf
+	}
+	return l
+}
+
+// FormatMoney formats an amount of money in an ISO 4217 currency with the
+// conventions of the given language. An empty or unsupported language uses the
+// default language.
+//
+// The amount can be an int or int64 in minor units (eg: cents) or a decimal
+// string with "." as decimal separator (eg: "1234.5"). Decimal strings with more
+// digits than the currency allows are rounded half away from zero. No floating
+// point arithmetic is involved. Unknown currency codes are written as is with
+// two decimals.
+//
+// Example usage:
+//
+//	translator.FormatMoney("en", 123450, "USD")     // "$1,234.50"
+//	translator.FormatMoney("es", 123450, "USD")     // "1234,50 US$"
+//	translator.FormatMoney("de", "1234.5", "EUR")   // "1.234,50 €"
+//	translator.FormatMoney("hi", "123456", "INR")   // "₹1,23,456.00"
+func (l Translator) FormatMoney(lang string, amount any, currencyCode string) (string, error) {
+	idx := l.langIndex(lang)
+	conv := l.langSupported[idx]
+
+	cur, ok := currencies[currencyCode]
+	if !ok {
+		cur = currency{symbol: currencyCode, narrow: currencyCode, digits: 2}
+	}
+
+	var minor int64
+	switch v := amount.(type) {
+	case int:
+		minor = int64(v)
+	case int64:
+		minor = v
+	case string:
+		var problem string
+		if minor, problem = parseMinorUnits(v, cur.digits); problem != "" {
+			return "", l.Err(conv.Code, D.Value, v, problem)
+		}
+	default:
+		return "", l.Err(conv.Code, D.UnsupportedType)
+	}
+
+	symbol := cur.symbol
+	for _, local := range conv.currency.Local {
+		if local == currencyCode {
+			symbol = cur.narrow
+			break
+		}
+	}
+
+	var sign string
+	abs := uint64(minor)
+	if minor < 0 {
+		sign, abs = "-", uint64(-(minor+1))+1
+	}
+
+	number := conv.number.formatMinorUnits(abs, cur.digits)
+	if conv.currency.SymbolBefore {
+		return sign + symbol + conv.currency.Space + number, nil
+	}
+	return sign + number + conv.currency.Space + symbol, nil
+}
+
+// formatMinorUnits formats an amount in minor units with a fixed number of decimals
+func (nf NumberFormat) formatMinorUnits(minor uint64, digits int) string {
+	s := strconv.FormatUint(minor, 10)
+	if digits <= 0 {
+		return nf.shape(nf.group(s))
+	}
+	for len(s) <= digits {
+		s = "0" + s
+	}
+	cut := len(s) - digits
+	return nf.shape(nf.group(s[:cut]) + nf.Decimal + s[cut:])
+}
+
+// parseMinorUnits converts a decimal string such as "-1234.567" into minor
+// units with the given digits, rounding half away from zero. On failure it
+// returns the dictionary key describing the problem.
+func parseMinorUnits(s string, digits int) (int64, string) {
+	s = NormalizeDigits(strings.TrimSpace(s))
+
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	integer, fraction, _ := strings.Cut(s, ".")
+	if integer == "" && fraction == "" || !isDigits(integer) || !isDigits(fraction) {
+		return 0, D.NotNumber
+	}
+
+	// pad or cut the fraction to the digits of the currency
+	roundUp := len(fraction) > digits && fraction[digits] >= '5'
+	for len(fraction) < digits {
+		fraction += "0"
+	}
+	fraction = fraction[:digits]
+
+	minor, err := strconv.ParseInt(integer+fraction, 10, 64)
+	if integer+fraction == "" {
+		minor, err = 0, nil
+	}
+	if err != nil {
+		return 0, D.OutOfRange
+	}
+	if roundUp {
+		if minor == math.MaxInt64 {
+			return 0, D.OutOfRange
+		}
+		minor++
+	}
+	if negative {
+		minor = -minor
+	}
+	return minor, ""
+}
+
+// isDigits reports whether s only contains ASCII digits
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
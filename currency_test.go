@@ -0,0 +1,65 @@
+package tinytranslator
+
+import "testing"
+
+func TestFormatMoney(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang     string
+		amount   any
+		currency string
+		want     string
+	}{
+		{"en", 123450, "USD", "$1,234.50"},
+		{"es", 123450, "USD", "1234,50" + nbsp + "US$"},
+		{"de", "1234.5", "EUR", "1.234,50" + nbsp + "€"},
+		{"fr", int64(123450), "EUR", "1" + narrowNbsp + "234,50" + nbsp + "€"},
+		{"hi", "123456", "INR", "₹1,23,456.00"},
+		{"pt", "0.05", "BRL", "R$" + nbsp + "0,05"},
+		{"en", -5, "USD", "-$0.05"},
+		{"en", "-1234.567", "USD", "-$1,234.57"},
+		{"en", "0.125", "USD", "$0.13"},
+		{"en", "1234", "JPY", "¥1,234"},
+		{"en", 1234567, "KWD", "KWD1,234.567"},
+		{"zh", "99.9", "CNY", "¥99.90"},
+		{"en", "0.1", "XYZ", "XYZ0.10"},
+		{"en", ".5", "USD", "$0.50"},
+	}
+
+	for _, tt := range tests {
+		got, err := translator.FormatMoney(tt.lang, tt.amount, tt.currency)
+		if err != nil {
+			t.Errorf("FormatMoney(%q, %v, %q) error: %v", tt.lang, tt.amount, tt.currency, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("FormatMoney(%q, %v, %q) = %q; want %q", tt.lang, tt.amount, tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestFormatMoneyErrors(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang     string
+		amount   any
+		currency string
+		want     string
+	}{
+		{"en", "12a", "USD", "value 12a not number"},
+		{"es", "", "USD", "valor no es un numero"},
+		{"en", "99999999999999999999", "USD", "value 99999999999999999999 out of range"},
+		{"en", 1.5, "USD", "unsupported type"},
+		{"en", "9223372036854775807.9", "JPY", "value 9223372036854775807.9 out of range"},
+		{"en", "92233720368547758.079", "USD", "value 92233720368547758.079 out of range"},
+	}
+
+	for _, tt := range tests {
+		_, err := translator.FormatMoney(tt.lang, tt.amount, tt.currency)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("FormatMoney(%q, %v, %q) error = %v; want %q", tt.lang, tt.amount, tt.currency, err, tt.want)
+		}
+	}
+}
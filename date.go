@@ -0,0 +1,330 @@
+package tinytranslator
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DateStyle selects the length of a formatted date
+type DateStyle int
+
+const (
+	DateShort  DateStyle = iota // eg: "4/3/25"
+	DateMedium                  // eg: "Apr 3, 2025"
+	DateLong                    // eg: "April 3, 2025"
+	DateFull                    // eg: "Thursday, April 3, 2025"
+)
+
+// Capitalization of the month and weekday names in formatted dates
+type Capitalization int
+
+const (
+	KeepCase  Capitalization = iota // names are written as in the dictionary
+	LowerCase                       // eg: "abril"
+	TitleCase                       // eg: "April"
+)
+
+// DateFormat defines how dates and times are written in a language.
+//
+// Patterns use the letters: y (year, yy two digits), M (month: M, MM, MMM short
+// name, MMMM name), d (day: d, dd), E (weekday: E short name, EEEE name),
+// H (hour 0-23), h (hour 1-12), m (minute), s (second) and a (AM/PM).
+// Text between single quotes is written literally, eg: "d 'de' MMMM 'de' y".
+type DateFormat struct {
+	Patterns       [4]string      // date patterns indexed by DateStyle
+	Time           string         // time pattern, eg: "HH:mm"
+	Months         [12]string     // month names, empty to use the dictionary, eg: genitive in ru
+	ShortMonths    [12]string     // abbreviated month names
+	ShortDays      [7]string      // abbreviated weekday names, starting on Sunday
+	AmPm           [2]string      // day period names used by the "a" letter
+	Capitalization Capitalization // KeepCase, LowerCase or TitleCase
+}
+
+// DefaultDateFormat returns the built-in date format of a language code.
+// Languages without specific conventions use the english ones.
+func DefaultDateFormat(code string) DateFormat {
+	switch code {
+	case "es":
+		return DateFormat{
+			Patterns:       [4]string{"d/M/yy", "d MMM y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+			Time:           "H:mm",
+			ShortMonths:    [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+			ShortDays:      [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+			Capitalization: LowerCase,
+		}
+	case "pt":
+		return DateFormat{
+			Patterns:       [4]string{"dd/MM/y", "d 'de' MMM 'de' y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+			Time:           "HH:mm",
+			ShortMonths:    [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+			ShortDays:      [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+			Capitalization: LowerCase,
+		}
+	case "fr":
+		return DateFormat{
+			Patterns:       [4]string{"dd/MM/y", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+			Time:           "HH:mm",
+			ShortMonths:    [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+			ShortDays:      [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+			Capitalization: LowerCase,
+		}
+	case "ru":
+		return DateFormat{
+			Patterns:       [4]string{"dd.MM.y", "d MMM y 'г'.", "d MMMM y 'г'.", "EEEE, d MMMM y 'г'."},
+			Time:           "HH:mm",
+			Months:         [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+			ShortMonths:    [12]string{"янв.", "февр.", "мар.", "апр.", "мая", "июн.", "июл.", "авг.", "сент.", "окт.", "нояб.", "дек."},
+			ShortDays:      [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+			Capitalization: LowerCase,
+		}
+	case "de":
+		return DateFormat{
+			Patterns:    [4]string{"dd.MM.yy", "dd.MM.y", "d. MMMM y", "EEEE, d. MMMM y"},
+			Time:        "HH:mm",
+			ShortMonths: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+			ShortDays:   [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		}
+	case "it":
+		return DateFormat{
+			Patterns:       [4]string{"dd/MM/yy", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+			Time:           "HH:mm",
+			ShortMonths:    [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+			ShortDays:      [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+			Capitalization: LowerCase,
+		}
+	case "hi":
+		return DateFormat{
+			Patterns:    [4]string{"d/M/yy", "d MMM y", "d MMMM y", "EEEE, d MMMM y"},
+			Time:        "h:mm a",
+			ShortMonths: [12]string{"जन॰", "फ़र॰", "मार्च", "अप्रैल", "मई", "जून", "जुल॰", "अग॰", "सित॰", "अक्तू॰", "नव॰", "दिस॰"},
+			ShortDays:   [7]string{"रवि", "सोम", "मंगल", "बुध", "गुरु", "शुक्र", "शनि"},
+			AmPm:        [2]string{"am", "pm"},
+		}
+	case "bn":
+		return DateFormat{
+			Patterns:    [4]string{"d/M/yy", "d MMM, y", "d MMMM, y", "EEEE, d MMMM, y"},
+			Time:        "h:mm a",
+			ShortMonths: [12]string{"জানু", "ফেব", "মার্চ", "এপ্রি", "মে", "জুন", "জুল", "আগ", "সেপ", "অক্টো", "নভে", "ডিসে"},
+			ShortDays:   [7]string{"রবি", "সোম", "মঙ্গল", "বুধ", "বৃহস্পতি", "শুক্র", "শনি"},
+			AmPm:        [2]string{"AM", "PM"},
+		}
+	case "id":
+		return DateFormat{
+			Patterns:    [4]string{"dd/MM/yy", "d MMM y", "d MMMM y", "EEEE, dd MMMM y"},
+			Time:        "HH.mm",
+			ShortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "Mei", "Jun", "Jul", "Agu", "Sep", "Okt", "Nov", "Des"},
+			ShortDays:   [7]string{"Min", "Sen", "Sel", "Rab", "Kam", "Jum", "Sab"},
+		}
+	case "ar":
+		return DateFormat{
+			Patterns:    [4]string{"d/M/y", "dd/MM/y", "d MMMM y", "EEEE، d MMMM y"},
+			Time:        "h:mm a",
+			ShortMonths: [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+			ShortDays:   [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+			AmPm:        [2]string{"ص", "م"},
+		}
+	case "ur":
+		return DateFormat{
+			Patterns:    [4]string{"d/M/yy", "d MMM، y", "d MMMM، y", "EEEE، d MMMM، y"},
+			Time:        "h:mm a",
+			ShortMonths: [12]string{"جنوری", "فروری", "مارچ", "اپریل", "مئی", "جون", "جولائی", "اگست", "ستمبر", "اکتوبر", "نومبر", "دسمبر"},
+			ShortDays:   [7]string{"اتوار", "پیر", "منگل", "بدھ", "جمعرات", "جمعہ", "ہفتہ"},
+			AmPm:        [2]string{"AM", "PM"},
+		}
+	case "zh":
+		return DateFormat{
+			Patterns:    [4]string{"y/M/d", "y年M月d日", "y年M月d日", "y年M月d日EEEE"},
+			Time:        "HH:mm",
+			ShortMonths: [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+			ShortDays:   [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		}
+	}
+
+	return DateFormat{
+		Patterns:       [4]string{"M/d/yy", "MMM d, y", "MMMM d, y", "EEEE, MMMM d, y"},
+		Time:           "h:mm a",
+		ShortMonths:    [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		ShortDays:      [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		AmPm:           [2]string{"AM", "PM"},
+		Capitalization: TitleCase,
+	}
+}
+
+// WithDateFormat overrides the date format used for a language.
+// Unsupported language codes are ignored.
+func (l *Translator) WithDateFormat(code string, df DateFormat) *Translator {
+	if i := l.findLanguageIndex(code); i >= 0 {
+		l.langSupported[i].date = df
+	}
+	return l
+}
+
+// FormatDate formats a date with the conventions of the given language.
+// An empty or unsupported language uses the default language.
+//
+// Example usage:
+//
+//	date := time.Date(2025, time.April, 3, 0, 0, 0, 0, time.UTC)
+//	translator.FormatDate("es", date, DateLong) // "3 de abril de 2025"
+//	translator.FormatDate("fr", date, DateLong) // "3 avril 2025"
+//	translator.FormatDate("zh", date, DateLong) // "2025年4月3日"
+func (l Translator) FormatDate(lang string, t time.Time, style DateStyle) string {
+	idx := l.langIndex(lang)
+	if style < DateShort || style > DateFull {
+		style = DateMedium
+	}
+	return l.formatDatePattern(idx, t, l.langSupported[idx].date.Patterns[style])
+}
+
+// FormatTime formats the clock time with the conventions of the given language.
+// An empty or unsupported language uses the default language.
+//
+// Example usage:
+//
+//	translator.FormatTime("en", t) // "3:04 PM"
+//	translator.FormatTime("de", t) // "15:04"
+func (l Translator) FormatTime(lang string, t time.Time) string {
+	idx := l.langIndex(lang)
+	return l.formatDatePattern(idx, t, l.langSupported[idx].date.Time)
+}
+
+// formatDatePattern writes t following a date pattern in the language at idx
+func (l *Translator) formatDatePattern(idx int, t time.Time, pattern string) string {
+	lang := l.langSupported[idx]
+	df := lang.date
+	var out strings.Builder
+
+	// num writes a number with the digits of the language, zero padded to width
+	num := func(n, width int) {
+		s := strconv.Itoa(n)
+		for len(s) < width {
+			s = "0" + s
+		}
+		out.WriteString(lang.number.shape(s))
+	}
+
+	scanDatePattern(pattern, func(letter rune, n int, literal string) {
+		switch letter {
+		case 0:
+			out.WriteString(literal)
+		case 'y':
+			if n == 2 {
+				num(t.Year()%100, 2)
+			} else {
+				num(t.Year(), n)
+			}
+		case 'M':
+			switch {
+			case n >= 4:
+				out.WriteString(l.monthName(idx, t.Month()))
+			case n == 3:
+				out.WriteString(df.ShortMonths[t.Month()-1])
+			default:
+				num(int(t.Month()), n)
+			}
+		case 'd':
+			num(t.Day(), n)
+		case 'E':
+			if n >= 4 {
+				out.WriteString(l.weekdayName(idx, t.Weekday()))
+			} else {
+				out.WriteString(df.ShortDays[t.Weekday()])
+			}
+		case 'H':
+			num(t.Hour(), n)
+		case 'h':
+			h := t.Hour() % 12
+			if h == 0 {
+				h = 12
+			}
+			num(h, n)
+		case 'm':
+			num(t.Minute(), n)
+		case 's':
+			num(t.Second(), n)
+		case 'a':
+			out.WriteString(df.AmPm[t.Hour()/12])
+		}
+	})
+
+	return out.String()
+}
+
+// scanDatePattern walks a date pattern calling fn for each run of a pattern
+// letter with its length, or with letter 0 and the text of a literal.
+func scanDatePattern(pattern string, fn func(letter rune, n int, literal string)) {
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		// quoted literal text, '' is a single quote
+		if r == '\'' {
+			j := i + 1
+			for j < len(runes) && runes[j] != '\'' {
+				j++
+			}
+			if j == i+1 {
+				fn(0, 0, "'")
+			} else {
+				fn(0, 0, string(runes[i+1:j]))
+			}
+			i = j + 1
+			continue
+		}
+
+		// count repeated letters
+		n := 1
+		for i+n < len(runes) && runes[i+n] == r {
+			n++
+		}
+
+		if strings.ContainsRune(datePatternLetters, r) {
+			fn(r, n, "")
+		} else {
+			fn(0, 0, string(runes[i:i+n]))
+		}
+		i += n
+	}
+}
+
+// datePatternLetters are the letters with meaning in a date pattern
+const datePatternLetters = "yMdEHhmsa"
+
+// monthName returns the name of a month in the language at idx
+func (l *Translator) monthName(idx int, m time.Month) string {
+	df := l.langSupported[idx].date
+	if name := df.Months[m-1]; name != "" {
+		return name
+	}
+	return applyCase(l.dictionaryMonth(idx, m), df.Capitalization)
+}
+
+// dictionaryMonth returns the dictionary translation of a month in the language at idx
+func (l *Translator) dictionaryMonth(idx int, m time.Month) string {
+	months := [12]string{D.January, D.February, D.March, D.April, D.May, D.June,
+		D.July, D.August, D.September, D.October, D.November, D.December}
+	return l.findTranslation(months[m-1], idx)
+}
+
+// weekdayName returns the name of a weekday in the language at idx
+func (l *Translator) weekdayName(idx int, d time.Weekday) string {
+	days := [7]string{D.Sunday, D.Monday, D.Tuesday, D.Wednesday, D.Thursday, D.Friday, D.Saturday}
+	return applyCase(l.findTranslation(days[d], idx), l.langSupported[idx].date.Capitalization)
+}
+
+// applyCase changes the capitalization of a name
+func applyCase(s string, capitalization Capitalization) string {
+	switch capitalization {
+	case LowerCase:
+		return strings.ToLower(s)
+	case TitleCase:
+		if s != "" {
+			r, size := utf8.DecodeRuneInString(s)
+			return string(unicode.ToUpper(r)) + s[size:]
+		}
+	}
+	return s
+}
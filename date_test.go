@@ -0,0 +1,76 @@
+package tinytranslator
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDate(t *testing.T) {
+	translator := NewTranslationEngine()
+	date := time.Date(2025, time.April, 3, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		lang  string
+		style DateStyle
+		want  string
+	}{
+		{"en", DateShort, "4/3/25"},
+		{"en", DateMedium, "Apr 3, 2025"},
+		{"en", DateLong, "April 3, 2025"},
+		{"en", DateFull, "Thursday, April 3, 2025"},
+		{"es", DateLong, "3 de abril de 2025"},
+		{"es", DateFull, "jueves, 3 de abril de 2025"},
+		{"pt", DateShort, "03/04/2025"},
+		{"fr", DateLong, "3 avril 2025"},
+		{"fr", DateMedium, "3 avr. 2025"},
+		{"de", DateLong, "3. April 2025"},
+		{"ru", DateLong, "3 апреля 2025 г."},
+		{"it", DateFull, "giovedì 3 aprile 2025"},
+		{"zh", DateLong, "2025年4月3日"},
+		{"zh", DateFull, "2025年4月3日星期四"},
+		{"ar", DateLong, "3 أبريل 2025"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.FormatDate(tt.lang, date, tt.style); got != tt.want {
+			t.Errorf("FormatDate(%q, %d) = %q; want %q", tt.lang, tt.style, got, tt.want)
+		}
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	translator := NewTranslationEngine()
+	date := time.Date(2025, time.April, 3, 15, 4, 5, 0, time.UTC)
+
+	if got := translator.FormatTime("en", date); got != "3:04 PM" {
+		t.Errorf("FormatTime(en) = %q; want %q", got, "3:04 PM")
+	}
+	if got := translator.FormatTime("de", date); got != "15:04" {
+		t.Errorf("FormatTime(de) = %q; want %q", got, "15:04")
+	}
+}
+
+func TestDateArgument(t *testing.T) {
+	translator := NewTranslationEngine().WithNativeDigits("ar")
+	date := time.Date(2025, time.April, 3, 0, 0, 0, 0, time.UTC)
+
+	if got := translator.T("es", D.BirthDate, ':', date); got != "fecha de nacimiento: 3 de abril de 2025" {
+		t.Errorf("T() = %q", got)
+	}
+	if got := translator.T("ar", date); got != "٣ أبريل ٢٠٢٥" {
+		t.Errorf("T() with native digits = %q", got)
+	}
+}
+
+func TestDatePatternLiterals(t *testing.T) {
+	df := DefaultDateFormat("en")
+	df.Patterns[DateShort] = "'Day' dd 'of' MMM ''yy"
+
+	translator := NewTranslationEngine().WithDateFormat("en", df)
+	date := time.Date(2025, time.April, 3, 0, 0, 0, 0, time.UTC)
+
+	want := "Day 03 of Apr '25"
+	if got := translator.FormatDate("en", date, DateShort); got != want {
+		t.Errorf("FormatDate() = %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,224 @@
+package tinytranslator
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// dateToken is a word or a number of a date written by a user
+type dateToken struct {
+	text   string
+	number bool
+}
+
+// ParseDate parses a date entered by a user with the conventions of the given
+// language. An empty or unsupported language uses the default language.
+//
+// Numeric dates follow the order of the short date pattern of the language
+// (eg: "3/4/2025" is the 3rd of April in es, March 4th in en) and accept "/",
+// "-", "." or spaces as separators. Month names and abbreviations of the
+// language are recognized (eg: "3 de abril de 2025"), as well as native digits.
+//
+// Errors are translated to the same language using the dictionary entries
+// InvalidDateFormat, DayCannotBeZero, MonthOutOfRange and YearOutOfRange.
+//
+// Example usage:
+//
+//	date, err := translator.ParseDate("es", "3 de abril de 2025")
+//	date, err := translator.ParseDate("de", "03.04.2025")
+//	_, err := translator.ParseDate("es", "0/4/2025") // "día no puede ser cero"
+func (l Translator) ParseDate(lang string, input string) (time.Time, error) {
+	idx := l.langIndex(lang)
+	code := l.langSupported[idx].Code
+	df := l.langSupported[idx].date
+
+	invalid := func() (time.Time, error) {
+		return time.Time{}, l.Err(code, D.InvalidDateFormat, ':', input)
+	}
+
+	tokens := tokenizeDate(input)
+	if len(tokens) == 0 {
+		return invalid()
+	}
+
+	// words allowed between the parts of a date, eg: "de" in es, "г" in ru
+	fillers := dateLiterals(df)
+
+	day, month, year := -1, -1, -1
+	var numbers []dateToken
+	for _, tok := range tokens {
+		if tok.number {
+			numbers = append(numbers, tok)
+			continue
+		}
+		if m := l.parseMonthName(idx, tok.text); m > 0 && month < 0 {
+			month = m
+			continue
+		}
+		if fillers[strings.ToLower(tok.text)] || l.isWeekdayName(idx, tok.text) {
+			continue
+		}
+		return invalid()
+	}
+
+	// assign numbers following the order of the short pattern
+	var order []rune
+	for _, field := range dateFieldOrder(df.Patterns[DateShort]) {
+		if field == 'M' && month > 0 {
+			continue
+		}
+		order = append(order, field)
+	}
+	if len(numbers) != len(order) {
+		return invalid()
+	}
+
+	for i, field := range order {
+		n, err := strconv.Atoi(numbers[i].text)
+		if err != nil {
+			return invalid()
+		}
+		switch field {
+		case 'd':
+			day = n
+		case 'M':
+			month = n
+		case 'y':
+			year = n
+			if len(numbers[i].text) <= 2 {
+				year = expandTwoDigitYear(n)
+			}
+		}
+	}
+
+	if day == 0 {
+		return time.Time{}, l.Err(code, D.DayCannotBeZero)
+	}
+	if month < 1 || month > 12 {
+		return time.Time{}, l.Err(code, D.MonthOutOfRange)
+	}
+	if year < 1 || year > 9999 {
+		return time.Time{}, l.Err(code, D.YearOutOfRange)
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day {
+		// day beyond the length of the month, eg: 31/02
+		return time.Time{}, l.Err(code, D.Day, day, D.OutOfRange)
+	}
+	return date, nil
+}
+
+// tokenizeDate splits user input into numbers and words. Native digits are
+// converted to ASCII and any other character is treated as a separator.
+func tokenizeDate(input string) []dateToken {
+	var tokens []dateToken
+	var current strings.Builder
+	var number bool
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, dateToken{text: current.String(), number: number})
+			current.Reset()
+		}
+	}
+
+	for _, r := range input {
+		r = digitToASCII(r)
+		switch {
+		case r >= '0' && r <= '9':
+			if !number {
+				flush()
+			}
+			number = true
+			current.WriteRune(r)
+		case unicode.IsLetter(r) || (unicode.IsMark(r) && current.Len() > 0 && !number):
+			if number {
+				flush()
+			}
+			number = false
+			current.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// dateFieldOrder returns the order of the day, month and year in a pattern
+func dateFieldOrder(pattern string) []rune {
+	var order []rune
+	scanDatePattern(pattern, func(letter rune, n int, literal string) {
+		if (letter == 'd' || letter == 'M' || letter == 'y') && !strings.ContainsRune(string(order), letter) {
+			order = append(order, letter)
+		}
+	})
+	return order
+}
+
+// dateLiterals returns the lowercase words written literally in the date
+// patterns of a format
+func dateLiterals(df DateFormat) map[string]bool {
+	words := make(map[string]bool)
+	for _, pattern := range df.Patterns {
+		scanDatePattern(pattern, func(letter rune, n int, literal string) {
+			if letter != 0 {
+				return
+			}
+			for _, tok := range tokenizeDate(literal) {
+				if !tok.number {
+					words[strings.ToLower(tok.text)] = true
+				}
+			}
+		})
+	}
+	return words
+}
+
+// parseMonthName returns the month number of a name or abbreviation in the
+// language at idx, or 0 when the word is not a month
+func (l *Translator) parseMonthName(idx int, word string) int {
+	df := l.langSupported[idx].date
+	for m := time.January; m <= time.December; m++ {
+		candidates := []string{
+			l.monthName(idx, m),
+			l.dictionaryMonth(idx, m),
+			df.ShortMonths[m-1],
+		}
+		for _, c := range candidates {
+			c = strings.TrimRight(c, ".॰")
+			if c != "" && strings.EqualFold(c, word) {
+				return int(m)
+			}
+		}
+	}
+	return 0
+}
+
+// isWeekdayName reports whether a word is a weekday name or abbreviation
+// in the language at idx
+func (l *Translator) isWeekdayName(idx int, word string) bool {
+	df := l.langSupported[idx].date
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		for _, c := range []string{l.weekdayName(idx, d), df.ShortDays[d]} {
+			c = strings.TrimRight(c, ".")
+			if c != "" && strings.EqualFold(c, word) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// expandTwoDigitYear converts a two digit year following POSIX rules:
+// 69-99 are 1969-1999 and 00-68 are 2000-2068
+func expandTwoDigitYear(y int) int {
+	if y >= 69 {
+		return 1900 + y
+	}
+	return 2000 + y
+}
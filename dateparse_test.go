@@ -0,0 +1,72 @@
+package tinytranslator
+
+import "testing"
+
+func TestParseDate(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang  string
+		input string
+		want  string // yyyy-mm-dd
+	}{
+		{"es", "3/4/2025", "2025-04-03"},
+		{"en", "4/3/2025", "2025-04-03"},
+		{"en", "April 3, 2025", "2025-04-03"},
+		{"en", "Thursday, Apr 3, 2025", "2025-04-03"},
+		{"es", "3 de abril de 2025", "2025-04-03"},
+		{"es", "jueves, 3 de Abril de 2025", "2025-04-03"},
+		{"es", "3-4-25", "2025-04-03"},
+		{"de", "03.04.2025", "2025-04-03"},
+		{"de", "3. April 2025", "2025-04-03"},
+		{"fr", "3 avr. 2025", "2025-04-03"},
+		{"ru", "3 апреля 2025 г.", "2025-04-03"},
+		{"ru", "3 апрель 2025", "2025-04-03"},
+		{"zh", "2025年4月3日", "2025-04-03"},
+		{"zh", "2025/4/3", "2025-04-03"},
+		{"ar", "٣/٤/٢٠٢٥", "2025-04-03"},
+		{"hi", "3 अप्रैल 2025", "2025-04-03"},
+		{"en", "12/31/99", "1999-12-31"},
+	}
+
+	for _, tt := range tests {
+		got, err := translator.ParseDate(tt.lang, tt.input)
+		if err != nil {
+			t.Errorf("ParseDate(%q, %q) error: %v", tt.lang, tt.input, err)
+			continue
+		}
+		if got.Format("2006-01-02") != tt.want {
+			t.Errorf("ParseDate(%q, %q) = %s; want %s", tt.lang, tt.input, got.Format("2006-01-02"), tt.want)
+		}
+	}
+}
+
+func TestParseDateErrors(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang  string
+		input string
+		want  string
+	}{
+		{"es", "0/4/2025", "día no puede ser cero"},
+		{"en", "4/0/2025", "day cannot be zero"},
+		{"es", "3/13/2025", "mes fuera de rango"},
+		{"es", "3/4/10000", "año fuera de rango"},
+		{"en", "2/31/2025", "day 31 out of range"},
+		{"en", "", "invalid date format:"},
+		{"es", "3 de foo de 2025", "formato de fecha ingresado incorrecto: 3 de foo de 2025"},
+		{"en", "4/3", "invalid date format: 4/3"},
+	}
+
+	for _, tt := range tests {
+		_, err := translator.ParseDate(tt.lang, tt.input)
+		if err == nil {
+			t.Errorf("ParseDate(%q, %q) expected error", tt.lang, tt.input)
+			continue
+		}
+		if err.Error() != tt.want {
+			t.Errorf("ParseDate(%q, %q) error = %q; want %q", tt.lang, tt.input, err.Error(), tt.want)
+		}
+	}
+}
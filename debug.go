@@ -0,0 +1,64 @@
+package tinytranslator
+
+import "strings"
+
+// DebugMode selects how T() shows the keys behind the translated text
+type DebugMode int
+
+const (
+	DebugOff      DebugMode = iota // translations only
+	DebugKeys                      // keys instead of translations, eg: "language"
+	DebugAnnotate                  // translations followed by their keys, eg: "idioma⟦language⟧"
+)
+
+// Debug marks, eg: "language⟦→en⟧" for a fallback and "Go⟦?⟧" for a text that is not a key
+const (
+	debugOpen     = "⟦"
+	debugClose    = "⟧"
+	debugFallback = "→"
+	debugUnknown  = "?"
+)
+
+// WithDebug sets the debug mode of T(). It is safe to switch it at any time,
+// even while other goroutines are translating.
+//
+// In DebugKeys each dictionary entry is written as its key, and in
+// DebugAnnotate as its text followed by the key. Entries taken from the
+// default language because the target one is empty add "→" and that language,
+// and texts that are not keys are marked with "?".
+//
+// Example usage:
+//
+//	translator.WithDebug(DebugAnnotate)
+//	translator.T("es", D.Language, "Go") // "idioma⟦language⟧ Go⟦?⟧"
+//	translator.WithDebug(DebugKeys)
+//	translator.T("es", D.Language)       // "language"
+//	translator.WithDebug(DebugOff)
+func (l *Translator) WithDebug(mode DebugMode) *Translator {
+	l.debug.Store(int32(mode))
+	return l
+}
+
+// debugText writes a text resolved from key in the language at idx for the debug mode,
+// from is the index of the language the text was taken from, -1 when key is unknown
+func (l *Translator) debugText(mode DebugMode, key, text string, from, idx int) string {
+	if from < 0 {
+		return text + debugOpen + debugUnknown + debugClose
+	}
+
+	var mark string
+	if from != idx {
+		mark = debugFallback + l.langSupported[from].Code
+	}
+
+	// Context qualified keys are shown as "context|key"
+	key = strings.Replace(key, ctxSeparator, "|", 1)
+
+	if mode == DebugKeys {
+		if mark == "" {
+			return key
+		}
+		return key + debugOpen + mark + debugClose
+	}
+	return text + debugOpen + key + mark + debugClose
+}
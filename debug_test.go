@@ -0,0 +1,63 @@
+package tinytranslator
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestDebugModes(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	var form struct {
+		Nickname string `es:"apodo"`
+	}
+	if err := translator.LoadNamespace("form", &form); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+
+	tests := []struct {
+		mode DebugMode
+		args []any
+		want string
+	}{
+		{DebugOff, []any{"es", D.Language, "Go"}, "idioma Go"},
+		{DebugKeys, []any{"es", D.Language, "Go"}, "language Go⟦?⟧"},
+		{DebugAnnotate, []any{"es", D.Language, "Go"}, "idioma⟦language⟧ Go⟦?⟧"},
+		{DebugKeys, []any{"fr", form.Nickname}, "form.nickname⟦→en⟧"},
+		{DebugAnnotate, []any{"fr", form.Nickname}, "nickname⟦form.nickname→en⟧"},
+		{DebugAnnotate, []any{"ru", D.SpaceChar}, "пробел⟦char|space⟧"},
+		{DebugAnnotate, []any{"es", Feminine, D.Empty}, "vacía⟦empty⟧"},
+		{DebugKeys, []any{"es", And{D.Letters, D.Numbers}}, "letters y numbers"},
+		{DebugOff, []any{"fr", form.Nickname}, "nickname"},
+	}
+
+	for _, tt := range tests {
+		translator.WithDebug(tt.mode)
+		if got := translator.T(tt.args...); got != tt.want {
+			t.Errorf("mode %d: T(%q) = %q; want %q", tt.mode, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestDebugSwitchWhileTranslating(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				switch got := translator.T("es", D.Language); got {
+				case "idioma", "language", "idioma⟦language⟧":
+				default:
+					t.Errorf("T() = %q while switching debug mode", got)
+				}
+			}
+		}()
+	}
+	for _, mode := range []DebugMode{DebugKeys, DebugAnnotate, DebugOff} {
+		translator.WithDebug(mode)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,95 @@
+package tinytranslator
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Native digit systems of the supported languages
+const (
+	arabicIndicDigits         = "٠١٢٣٤٥٦٧٨٩" // ar
+	extendedArabicIndicDigits = "۰۱۲۳۴۵۶۷۸۹" // ur
+	devanagariDigits          = "०१२३४५६७८९" // hi
+	bengaliDigits             = "০১২৩৪৫৬৭৮৯" // bn
+)
+
+// nativeDigits maps a language code to its native digits
+var nativeDigits = map[string]string{
+	"ar": arabicIndicDigits,
+	"ur": extendedArabicIndicDigits,
+	"hi": devanagariDigits,
+	"bn": bengaliDigits,
+}
+
+// digitZeros lists the zero of every digit system recognized when parsing
+var digitZeros = []rune{
+	'0',
+	0x0660, // Arabic-Indic
+	0x06F0, // Extended Arabic-Indic
+	0x0966, // Devanagari
+	0x09E6, // Bengali
+	0xFF10, // Fullwidth
+}
+
+// WithNativeDigits enables the native digit system of the given languages
+// when formatting numbers. Languages without a native digit system
+// (only ar, ur, hi and bn have one) or not supported are ignored.
+//
+// Arabic and Urdu also switch to the arabic decimal "٫" and grouping "٬" separators.
+//
+// Example usage:
+//
+//	translator := NewTranslationEngine().WithNativeDigits("ar", "hi")
+//	translator.T("ar", 2024)    // "٢٬٠٢٤"
+//	translator.T("hi", 1234567) // "१२,३४,५६७"
+func (l *Translator) WithNativeDigits(codes ...string) *Translator {
+	for _, code := range codes {
+		digits, ok := nativeDigits[code]
+		i := l.findLanguageIndex(code)
+		if !ok || i < 0 {
+			continue
+		}
+		nf := &l.langSupported[i].number
+		nf.Digits = digits
+		if code == "ar" || code == "ur" {
+			nf.Decimal, nf.Group = "٫", "٬"
+		}
+	}
+	return l
+}
+
+// NormalizeDigits replaces the digits of any recognized digit system
+// (Arabic-Indic, Extended Arabic-Indic, Devanagari, Bengali, Fullwidth)
+// with ASCII digits. Other characters are kept as is.
+//
+// Example usage:
+//
+//	NormalizeDigits("٢٠٢٤") // "2024"
+func NormalizeDigits(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		b.WriteRune(digitToASCII(r))
+	}
+	return b.String()
+}
+
+// digitToASCII returns the ASCII digit of r, or r itself when it is not a digit
+func digitToASCII(r rune) rune {
+	for _, zero := range digitZeros {
+		if r >= zero && r <= zero+9 {
+			return '0' + (r - zero)
+		}
+	}
+	return r
+}
+
+// ParseInt parses a base 10 integer that may be written with native digits.
+// Leading and trailing spaces are ignored.
+//
+// Example usage:
+//
+//	year, err := ParseInt("٢٠٢٤") // 2024, nil
+func ParseInt(s string) (int64, error) {
+	return strconv.ParseInt(NormalizeDigits(strings.TrimSpace(s)), 10, 64)
+}
@@ -0,0 +1,60 @@
+package tinytranslator
+
+import "testing"
+
+func TestWithNativeDigits(t *testing.T) {
+	translator := NewTranslationEngine().WithNativeDigits("ar", "ur", "hi", "bn", "es")
+
+	tests := []struct {
+		args []any
+		want string
+	}{
+		{[]any{"ar", 2024}, "٢٬٠٢٤"},
+		{[]any{"ar", 1234.5}, "١٬٢٣٤٫٥"},
+		{[]any{"ur", 2024}, "۲٬۰۲۴"},
+		{[]any{"hi", 1234567}, "१२,३४,५६७"},
+		{[]any{"bn", 2024}, "২,০২৪"},
+		{[]any{"es", 2024}, "2024"}, // no native digits
+		{[]any{"en", 2024}, "2,024"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.T(tt.args...); got != tt.want {
+			t.Errorf("T(%v) = %q; want %q", tt.args, got, tt.want)
+		}
+	}
+
+	// Other translators are not affected
+	if got := NewTranslationEngine().T("ar", 2024); got != "2,024" {
+		t.Errorf("T() without native digits = %q; want %q", got, "2,024")
+	}
+}
+
+func TestParseInt(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{"2024", 2024, false},
+		{"٢٠٢٤", 2024, false},
+		{"۲۰۲۴", 2024, false},
+		{" २०२४ ", 2024, false},
+		{"২০২৪", 2024, false},
+		{"-٥", -5, false},
+		{"２０２４", 2024, false},
+		{"20x4", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseInt(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseInt(%q) error = %v; wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseInt(%q) = %d; want %d", tt.input, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package tinytranslator
+
+// Formality is the register used to address the reader, eg: "tú" (Informal)
+// and "usted" (Formal) in es. DefaultFormality selects the default value of
+// each entry.
+type Formality int
+
+const (
+	DefaultFormality Formality = iota
+	Formal
+	Informal
+)
+
+// variant returns the tag suffix of the formality variants, eg: "formal" for `es_formal:"..."`
+func (f Formality) variant() string {
+	switch f {
+	case Formal:
+		return "formal"
+	case Informal:
+		return "informal"
+	}
+	return ""
+}
+
+// WithFormality sets the register used by T() when no Formality argument is
+// given. Entries without a variant for that register use their default value.
+//
+// Example usage:
+//
+//	translator := NewTranslationEngine().WithFormality(Informal)
+//	translator.T("es", D.Select, D.Date) // "selecciona fecha"
+//	translator.T("de", Formal, D.Select) // "wählen Sie aus"
+func (l *Translator) WithFormality(f Formality) *Translator {
+	l.formality = f
+	return l
+}
+
+// variants returns the tag suffixes to try for an entry, most specific first,
+// eg: "formal_f", "formal", "f"
+func variants(f Formality, g Gender) []string {
+	switch {
+	case f.variant() != "" && g.variant() != "":
+		return []string{f.variant() + "_" + g.variant(), f.variant(), g.variant()}
+	case f.variant() != "":
+		return []string{f.variant()}
+	case g.variant() != "":
+		return []string{g.variant()}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package tinytranslator
+
+import "testing"
+
+func TestFormalityVariants(t *testing.T) {
+	informal := NewTranslationEngine().WithFormality(Informal)
+	neutral := NewTranslationEngine()
+
+	tests := []struct {
+		name       string
+		translator *Translator
+		args       []any
+		want       string
+	}{
+		{"translator register", informal, []any{"es", D.Select, D.Date}, "selecciona fecha"},
+		{"per call overrides translator", informal, []any{"de", Formal, D.Select}, "wählen Sie aus"},
+		{"default register", neutral, []any{"es", D.Select}, "seleccionar"},
+		{"per call without translator register", neutral, []any{"fr", Formal, D.ConfirmPassword}, "confirmez le mot de passe"},
+		{"falls back without variant", informal, []any{"zh", D.Select}, "选择"},
+		{"reset to default", informal, []any{"es", DefaultFormality, D.Select}, "seleccionar"},
+		{"combined with gender", informal, []any{"es", Feminine, D.Select, D.Empty}, "selecciona vacía"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.translator.T(tt.args...); got != tt.want {
+			t.Errorf("%s: T(%v) = %q; want %q", tt.name, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestVariantsOrder(t *testing.T) {
+	got := variants(Formal, Feminine)
+	want := []string{"formal_f", "formal", "f"}
+	if len(got) != len(want) {
+		t.Fatalf("variants(Formal, Feminine) = %v; want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("variants(Formal, Feminine)[%d] = %q; want %q", i, got[i], want[i])
+		}
+	}
+	if got := variants(DefaultFormality, Neutral); len(got) != 0 {
+		t.Errorf("variants(DefaultFormality, Neutral) = %v; want none", got)
+	}
+}
@@ -0,0 +1,23 @@
+package tinytranslator
+
+// Gender is the grammatical gender used to select the form of a word that
+// agrees with a noun, eg: "1.º" (Masculine) and "1.ª" (Feminine) in es.
+// Neutral selects the default form of each language.
+type Gender int
+
+const (
+	Neutral Gender = iota
+	Masculine
+	Feminine
+)
+
+// variant returns the tag suffix of the gender variants, eg: "f" for `es_f:"..."`
+func (g Gender) variant() string {
+	switch g {
+	case Masculine:
+		return "m"
+	case Feminine:
+		return "f"
+	}
+	return ""
+}
@@ -0,0 +1,40 @@
+package tinytranslator
+
+import "testing"
+
+func TestGenderVariants(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		name string
+		args []any
+		want string
+	}{
+		{"feminine", []any{"es", D.Date, Feminine, D.NotValid}, "fecha no es valida"},
+		{"masculine falls back to default", []any{"es", D.Field, Masculine, D.NotValid}, "campo no es valido"},
+		{"default without gender", []any{"es", D.Date, D.NotValid}, "fecha no es valido"},
+		{"language without variant", []any{"fr", D.Date, Feminine, D.NotValid}, "date n'est pas valide"},
+		{"neutral resets", []any{"pt", Feminine, D.Empty, Neutral, D.Empty}, "vazia vazio"},
+		{"applies to slices", []any{"it", Feminine, []string{D.Empty, D.Unknown}}, "vuota sconosciuta"},
+		{"english default", []any{"en", Feminine, D.Unknown}, "unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.T(tt.args...); got != tt.want {
+			t.Errorf("%s: T(%v) = %q; want %q", tt.name, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestVariantTagsAreNotLanguages(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	for _, code := range translator.Languages() {
+		if isVariantTag(code) {
+			t.Errorf("variant tag %q listed as a language", code)
+		}
+	}
+	if got := translator.T("es_f", D.Empty); got != "es_f empty" {
+		t.Errorf("T(es_f) = %q; want variant tags not usable as languages", got)
+	}
+}
@@ -0,0 +1,90 @@
+package tinytranslator
+
+// Punctuation describes how a punctuation mark is written in a language
+type Punctuation struct {
+	Mark   string // text written for the mark, eg: ":" or "：" in zh
+	Before string // spacing written before the mark, eg: " " in fr
+	After  string // spacing written after the mark, replaces the word separator
+}
+
+// JoinRules defines how T() joins the parts of a message in a language
+type JoinRules struct {
+	Separator   string               // written between parts, eg: " " or "" in zh
+	Punctuation map[rune]Punctuation // rune arguments written as punctuation marks
+}
+
+// Spacing characters used by the punctuation rules
+const (
+	nbsp       = "\u00a0" // NO-BREAK SPACE
+	narrowNbsp = "\u202f" // NARROW NO-BREAK SPACE
+)
+
+// DefaultJoinRules returns the built-in joining rules for a language code.
+// The returned value is a new copy that can be modified and passed to
+// WithJoinRules. Languages without specific rules use the english ones.
+//
+// Example usage:
+//
+//	rules := DefaultJoinRules("fr")
+//	rules.Punctuation[':'] = Punctuation{Mark: ":", After: " "}
+//	translator := NewTranslationEngine().WithJoinRules("fr", rules)
+func DefaultJoinRules(code string) JoinRules {
+	rules := JoinRules{
+		Separator: " ",
+		Punctuation: map[rune]Punctuation{
+			':': {Mark: ":", After: " "},
+			',': {Mark: ",", After: " "},
+			'.': {Mark: ".", After: " "},
+			';': {Mark: ";", After: " "},
+			'!': {Mark: "!", After: " "},
+			'?': {Mark: "?", After: " "},
+		},
+	}
+
+	switch code {
+	case "fr":
+		// non-breaking space before high punctuation
+		rules.Punctuation[':'] = Punctuation{Mark: ":", Before: nbsp, After: " "}
+		rules.Punctuation[';'] = Punctuation{Mark: ";", Before: narrowNbsp, After: " "}
+		rules.Punctuation['!'] = Punctuation{Mark: "!", Before: narrowNbsp, After: " "}
+		rules.Punctuation['?'] = Punctuation{Mark: "?", Before: narrowNbsp, After: " "}
+	case "zh":
+		// no spaces between words, full width punctuation
+		rules.Separator = ""
+		rules.Punctuation[':'] = Punctuation{Mark: "："}
+		rules.Punctuation[','] = Punctuation{Mark: "，"}
+		rules.Punctuation['.'] = Punctuation{Mark: "。"}
+		rules.Punctuation[';'] = Punctuation{Mark: "；"}
+		rules.Punctuation['!'] = Punctuation{Mark: "！"}
+		rules.Punctuation['?'] = Punctuation{Mark: "？"}
+	case "ar", "ur":
+		// arabic comma, semicolon and question mark
+		rules.Punctuation[','] = Punctuation{Mark: "،", After: " "}
+		rules.Punctuation[';'] = Punctuation{Mark: "؛", After: " "}
+		rules.Punctuation['?'] = Punctuation{Mark: "؟", After: " "}
+		if code == "ur" {
+			rules.Punctuation['.'] = Punctuation{Mark: "۔", After: " "}
+		}
+	case "hi", "bn":
+		// danda as full stop
+		rules.Punctuation['.'] = Punctuation{Mark: "।", After: " "}
+	}
+
+	return rules
+}
+
+// WithJoinRules overrides the joining rules used by T() for a language.
+// Unsupported language codes are ignored.
+//
+// Example usage:
+//
+//	// join chinese words with spaces
+//	rules := DefaultJoinRules("zh")
+//	rules.Separator = " "
+//	translator := NewTranslationEngine().WithJoinRules("zh", rules)
+func (l *Translator) WithJoinRules(code string, rules JoinRules) *Translator {
+	if i := l.findLanguageIndex(code); i >= 0 {
+		l.langSupported[i].join = rules
+	}
+	return l
+}
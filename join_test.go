@@ -0,0 +1,49 @@
+package tinytranslator
+
+import "testing"
+
+func TestJoinRules(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		name string
+		args []any
+		want string
+	}{
+		{"en colon", []any{"en", D.Field, ':', D.Empty}, "field: empty"},
+		{"es colon", []any{"es", D.Field, ':', D.Empty}, "campo: vacío"},
+		{"fr colon", []any{"fr", D.Field, ':', D.Empty}, "champ" + nbsp + ": vide"},
+		{"fr question", []any{"fr", D.Email, '?'}, "e-mail" + narrowNbsp + "?"},
+		{"zh colon", []any{"zh", D.Field, ':', D.Empty}, "字段：空"},
+		{"zh words", []any{"zh", D.Hello, D.World}, "你好世界"},
+		{"ar comma", []any{"ar", D.Name, ',', D.Email}, "اسم، البريد الإلكتروني"},
+		{"leading mark", []any{"fr", ':', D.Empty}, ":" + " vide"},
+		{"other rune", []any{"en", D.Field, '-', D.Empty}, "field - empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := translator.T(tt.args...); got != tt.want {
+				t.Errorf("T() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithJoinRules(t *testing.T) {
+	rules := DefaultJoinRules("zh")
+	rules.Separator = " "
+	rules.Punctuation[':'] = Punctuation{Mark: ":", After: " "}
+
+	translator := NewTranslationEngine().WithJoinRules("zh", rules)
+
+	want := "字段: 空"
+	if got := translator.T("zh", D.Field, ':', D.Empty); got != want {
+		t.Errorf("T() = %q; want %q", got, want)
+	}
+
+	// Other languages keep their defaults
+	if got := translator.T("en", D.Hello, D.World); got != "hello world" {
+		t.Errorf("T() = %q; want %q", got, "hello world")
+	}
+}
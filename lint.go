@@ -0,0 +1,219 @@
+package tinytranslator
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// LintCheck names a rule of the translation linter
+type LintCheck string
+
+const (
+	LintCapitalization LintCheck = "capitalization" // month or weekday capitalized where the language writes it in lowercase, eg: "Abril" in es
+	LintWhitespace     LintCheck = "whitespace"     // leading, trailing or repeated spaces
+	LintPlaceholder    LintCheck = "placeholder"    // placeholders such as "{0}" or "%s" differ from english
+	LintScript         LintCheck = "script"         // letters of another script, eg: latin text in a ru entry
+	LintForeignWord    LintCheck = "foreign-word"   // word spelled like another language, eg: "tabulation" in es
+	LintDuplicate      LintCheck = "duplicate"      // same value as an entry with a different english value
+	LintUntranslated   LintCheck = "untranslated"   // same value as english
+)
+
+// LintIssue is a problem found in the value of an entry for a language
+type LintIssue struct {
+	Key   string    // key of the entry, eg: "april"
+	Lang  string    // language code or variant tag, eg: "es" or "es_f"
+	Check LintCheck // rule that failed
+	Value string    // value that failed the rule
+	Other string    // key of the other entry for LintDuplicate
+}
+
+// String returns the issue as "lang key: check "value"", keys qualified by a
+// context are written as "context|key"
+func (i LintIssue) String() string {
+	s := i.Lang + " " + strings.Replace(i.Key, ctxSeparator, "|", 1) + ": " + string(i.Check) + " " + `"` + i.Value + `"`
+	if i.Other != "" {
+		s += " = " + strings.Replace(i.Other, ctxSeparator, "|", 1)
+	}
+	return s
+}
+
+// lowercaseLanguages write month and weekday names in lowercase
+var lowercaseLanguages = []string{"es", "pt", "fr", "it", "ru"}
+
+// lowercaseNames are the english month and weekday names checked for
+// capitalization, other entries may be proper nouns, eg: "Google" or "Navidad"
+var lowercaseNames = []string{
+	"january", "february", "march", "april", "may", "june", "july",
+	"august", "september", "october", "november", "december",
+	"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday",
+}
+
+// languageScripts lists the script of the letters of each language
+var languageScripts = map[string]*unicode.RangeTable{
+	"en": unicode.Latin, "es": unicode.Latin, "pt": unicode.Latin, "fr": unicode.Latin,
+	"de": unicode.Latin, "it": unicode.Latin, "id": unicode.Latin,
+	"ru": unicode.Cyrillic, "zh": unicode.Han, "hi": unicode.Devanagari, "bn": unicode.Bengali,
+	"ar": unicode.Arabic, "ur": unicode.Arabic,
+}
+
+// foreignEndings are word endings foreign to the spelling of a language
+var foreignEndings = map[string][]string{
+	"es": {"tion", "tions", "ção", "ment"},
+	"pt": {"tion", "tions", "ción"},
+	"it": {"tion", "tions", "ción", "ção"},
+	"fr": {"ción", "ção"},
+}
+
+// Lint checks the values of every dictionary entry and returns the issues
+// found, in dictionary and language order. Variants such as `es_f` are checked
+// for capitalization, whitespace, placeholders, script and spelling.
+//
+// Example usage:
+//
+//	for _, issue := range translator.Lint() {
+//		fmt.Println(issue) // es april: capitalization "Abril"
+//	}
+func (l Translator) Lint() []LintIssue {
+	var issues []LintIssue
+
+	// seen maps the values of each language to the first entry using them
+	seen := make([]map[string]translation, len(l.langSupported))
+
+	for _, trans := range l.translations {
+		english := trans.Values[0]
+
+		for _, lang := range l.langSupported {
+			if isPseudoLocale(lang.Code) || lang.Index >= len(trans.Values) || trans.Values[lang.Index] == "" {
+				continue
+			}
+			value := trans.Values[lang.Index]
+			issues = append(issues, lintValue(trans, lang.Code, lang.Code, value)...)
+
+			if lang.Index == 0 {
+				continue
+			}
+
+			if strings.EqualFold(value, english) && len([]rune(english)) > 3 {
+				issues = append(issues, LintIssue{Key: trans.Key, Lang: lang.Code, Check: LintUntranslated, Value: value})
+			}
+
+			if seen[lang.Index] == nil {
+				seen[lang.Index] = make(map[string]translation)
+			}
+			folded := strings.ToLower(value)
+			if first, ok := seen[lang.Index][folded]; ok && !strings.EqualFold(first.Values[0], english) {
+				issues = append(issues, LintIssue{Key: trans.Key, Lang: lang.Code, Check: LintDuplicate, Value: value, Other: first.Key})
+			} else if !ok {
+				seen[lang.Index][folded] = trans
+			}
+		}
+
+		variants := make([]string, 0, len(trans.Variants))
+		for tag := range trans.Variants {
+			variants = append(variants, tag)
+		}
+		sort.Strings(variants)
+		for _, tag := range variants {
+			code, _, _ := strings.Cut(tag, "_")
+			issues = append(issues, lintValue(trans, code, tag, trans.Variants[tag])...)
+		}
+	}
+
+	return issues
+}
+
+// lintValue checks a value of an entry in a language, tag is reported as its language
+func lintValue(trans translation, code, tag, value string) []LintIssue {
+	var issues []LintIssue
+	issue := func(check LintCheck) {
+		issues = append(issues, LintIssue{Key: trans.Key, Lang: tag, Check: check, Value: value})
+	}
+	english := trans.Values[0]
+
+	if value != strings.TrimSpace(value) || strings.Contains(value, "  ") {
+		issue(LintWhitespace)
+	}
+
+	if slices.Contains(lowercaseLanguages, code) && slices.Contains(lowercaseNames, strings.ToLower(english)) && startsUpper(value) {
+		issue(LintCapitalization)
+	}
+
+	if !equalPlaceholders(placeholders(value), placeholders(english)) {
+		issue(LintPlaceholder)
+	}
+
+	if script, ok := languageScripts[code]; ok {
+		for _, r := range value {
+			// Letters shared by several scripts, eg: the arabic tatweel "ـ", are allowed
+			if unicode.IsLetter(r) && !unicode.Is(script, r) && !unicode.In(r, unicode.Common, unicode.Inherited) {
+				issue(LintScript)
+				break
+			}
+		}
+	}
+
+	for _, word := range strings.FieldsFunc(strings.ToLower(value), func(r rune) bool { return !unicode.IsLetter(r) }) {
+		if hasForeignEnding(code, word) {
+			issue(LintForeignWord)
+			break
+		}
+	}
+
+	return issues
+}
+
+// startsUpper reports whether the first letter of s is uppercase
+func startsUpper(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			return unicode.IsUpper(r)
+		}
+	}
+	return false
+}
+
+// hasForeignEnding reports whether a word ends like the words of another language
+func hasForeignEnding(code, word string) bool {
+	for _, ending := range foreignEndings[code] {
+		if strings.HasSuffix(word, ending) && len(word) > len(ending) {
+			return true
+		}
+	}
+	return false
+}
+
+// placeholders returns the sorted placeholders of a value, eg: "{0}" and "%s"
+func placeholders(s string) []string {
+	var out []string
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			if end := strings.IndexByte(s[i:], '}'); end > 0 {
+				out = append(out, s[i:i+end+1])
+				i += end
+			}
+		case '%':
+			if i+1 < len(s) && strings.IndexByte("sdvqf", s[i+1]) >= 0 {
+				out = append(out, s[i:i+2])
+				i++
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// equalPlaceholders reports whether two sorted lists of placeholders are equal
+func equalPlaceholders(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
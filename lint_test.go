@@ -0,0 +1,77 @@
+package tinytranslator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	var catalog struct {
+		Holiday   string `es:"Navidad" de:"Weihnachten"`
+		Brand     string `es:"Google" fr:"Google"`
+		April     string `es:"Abril" fr:"avril"`
+		Padded    string `es:" acolchado" fr:"rembourré  doux"`
+		Greeting  string `en:"hello {0}" es:"hola {1}" fr:"bonjour {0}"`
+		Schedule  string `ru:"schedule" zh:"日程"`
+		Tabbing   string `es:"tabulation"`
+		Copy      string `es:"copiar"`
+		Duplicate string `es:"copiar"`
+		Mode      string `it:"mode"`
+		Selected  string `es:"elegido" es_f:" elegida"`
+	}
+	if err := translator.LoadNamespace("lint", &catalog); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+
+	want := map[string]bool{
+		`es lint.april: capitalization "Abril"`:             true,
+		`es lint.padded: whitespace " acolchado"`:           true,
+		`fr lint.padded: whitespace "rembourré  doux"`:      true,
+		`es lint.greeting: placeholder "hola {1}"`:          true,
+		`ru lint.schedule: script "schedule"`:               true,
+		`ru lint.schedule: untranslated "schedule"`:         true,
+		`es lint.tabbing: foreign-word "tabulation"`:        true,
+		`es lint.duplicate: duplicate "copiar" = lint.copy`: true,
+		`it lint.mode: untranslated "mode"`:                 true,
+		`es_f lint.selected: whitespace " elegida"`:         true,
+	}
+
+	got := make(map[string]bool)
+	for _, issue := range translator.Lint() {
+		if strings.HasPrefix(issue.Key, "lint.") {
+			got[issue.String()] = true
+		}
+	}
+
+	for issue := range want {
+		if !got[issue] {
+			t.Errorf("missing issue %s", issue)
+		}
+	}
+	for issue := range got {
+		if !want[issue] {
+			t.Errorf("unexpected issue %s", issue)
+		}
+	}
+}
+
+func TestLintDictionary(t *testing.T) {
+	// Cognates and shared words are warnings, the other checks must pass
+	for _, issue := range NewTranslationEngine().Lint() {
+		switch issue.Check {
+		case LintUntranslated, LintDuplicate:
+			continue
+		}
+		t.Errorf("dictionary: %s", issue)
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	got := placeholders("{name} has %d of {0}, 100%")
+	want := []string{"%d", "{0}", "{name}"}
+	if !equalPlaceholders(got, want) {
+		t.Errorf("placeholders = %q; want %q", got, want)
+	}
+}
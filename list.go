@@ -0,0 +1,158 @@
+package tinytranslator
+
+import "strings"
+
+// ListStyle selects the kind of list written by FormatList
+type ListStyle int
+
+const (
+	ListAnd ListStyle = iota // eg: "a, b and c"
+	ListOr                   // eg: "a, b or c"
+)
+
+// And wraps a list of items so T() writes them as a conjunction, eg: "a, b and c".
+// Items that are dictionary keys are translated.
+//
+// Example usage:
+//
+//	translator.T("es", D.Allowed, ':', And{D.Letters, D.Numbers, D.Space}) // "permitido: letras, números y espacio"
+type And []string
+
+// Or wraps a list of items so T() writes them as a disjunction, eg: "a, b or c".
+// Items that are dictionary keys are translated.
+type Or []string
+
+// ListFormat defines how lists are written in a language
+type ListFormat struct {
+	Middle string // between items except the last two, eg: ", "
+	AndTwo string // between the items of a two item conjunction, eg: " and "
+	AndEnd string // before the last item of a longer conjunction, eg: ", and "
+	OrTwo  string // between the items of a two item disjunction, eg: " or "
+	OrEnd  string // before the last item of a longer disjunction, eg: ", or "
+}
+
+// DefaultListFormat returns the built-in list format of a language code.
+// Languages without specific conventions use the english ones.
+func DefaultListFormat(code string) ListFormat {
+	simple := func(and, or string) ListFormat {
+		return ListFormat{Middle: ", ", AndTwo: and, AndEnd: and, OrTwo: or, OrEnd: or}
+	}
+
+	switch code {
+	case "es":
+		return simple(" y ", " o ")
+	case "pt":
+		return simple(" e ", " ou ")
+	case "fr":
+		return simple(" et ", " ou ")
+	case "ru":
+		return simple(" и ", " или ")
+	case "de":
+		return simple(" und ", " oder ")
+	case "it":
+		return simple(" e ", " o ")
+	case "hi":
+		return simple(" और ", " या ")
+	case "bn":
+		return simple(" এবং ", " বা ")
+	case "id":
+		return ListFormat{Middle: ", ", AndTwo: " dan ", AndEnd: ", dan ", OrTwo: " atau ", OrEnd: ", atau "}
+	case "ar":
+		return ListFormat{Middle: " و", AndTwo: " و", AndEnd: " و", OrTwo: " أو ", OrEnd: " أو "}
+	case "ur":
+		return simple(" اور ", " یا ")
+	case "zh":
+		return ListFormat{Middle: "、", AndTwo: "和", AndEnd: "和", OrTwo: "或", OrEnd: "或"}
+	}
+	return ListFormat{Middle: ", ", AndTwo: " and ", AndEnd: ", and ", OrTwo: " or ", OrEnd: ", or "}
+}
+
+// WithListFormat overrides the list format used for a language.
+// Unsupported language codes are ignored.
+//
+// Example usage:
+//
+//	// english lists without serial comma
+//	lf := DefaultListFormat("en")
+//	lf.AndEnd = " and "
+//	translator := NewTranslationEngine().WithListFormat("en", lf)
+func (l *Translator) WithListFormat(code string, lf ListFormat) *Translator {
+	if i := l.findLanguageIndex(code); i >= 0 {
+		l.langSupported[i].list = lf
+	}
+	return l
+}
+
+// FormatList writes a list of items in the given language. Items that are
+// dictionary keys are translated and empty items are skipped. An empty or
+// unsupported language uses the default language.
+//
+// Example usage:
+//
+//	items := []string{D.Letters, D.Numbers, D.Space}
+//	translator.FormatList("en", items, ListAnd) // "letters, numbers, and space"
+//	translator.FormatList("es", items, ListOr)  // "letras, números o espacio"
+//	translator.FormatList("zh", items, ListAnd) // "字母、数字和空间"
+func (l Translator) FormatList(lang string, items []string, style ListStyle) string {
+	return l.formatList(l.langIndex(lang), items, style, false)
+}
+
+// formatList writes a list of items in the language at idx
+func (l *Translator) formatList(idx int, items []string, style ListStyle, isolate bool) string {
+	conv := l.langSupported[idx]
+
+	words := make([]string, 0, len(items))
+	for _, item := range items {
+		if item != "" {
+			words = append(words, l.translateValue(item, idx, isolate))
+		}
+	}
+
+	two, end := conv.list.AndTwo, conv.list.AndEnd
+	if style == ListOr {
+		two, end = conv.list.OrTwo, conv.list.OrEnd
+	}
+
+	switch len(words) {
+	case 0:
+		return ""
+	case 1:
+		return words[0]
+	case 2:
+		return words[0] + euphony(conv.Code, two, words[1]) + words[1]
+	}
+
+	var b strings.Builder
+	for i, w := range words {
+		switch {
+		case i == 0:
+		case i == len(words)-1:
+			b.WriteString(euphony(conv.Code, end, w))
+		default:
+			b.WriteString(conv.list.Middle)
+		}
+		b.WriteString(w)
+	}
+	return b.String()
+}
+
+// euphony adapts a conjunction to the word that follows it. In spanish "y"
+// becomes "e" before an "i" sound and "o" becomes "u" before an "o" sound,
+// eg: "padre e hijo", "siete u ocho".
+func euphony(code, conjunction, next string) string {
+	if code != "es" {
+		return conjunction
+	}
+	word := strings.ToLower(next)
+	switch strings.TrimSpace(conjunction) {
+	case "y":
+		if (strings.HasPrefix(word, "i") || strings.HasPrefix(word, "hi")) && !strings.HasPrefix(word, "hie") {
+			return strings.Replace(conjunction, "y", "e", 1)
+		}
+	case "o":
+		if strings.HasPrefix(word, "o") || strings.HasPrefix(word, "ho") {
+			return strings.Replace(conjunction, "o", "u", 1)
+		}
+	}
+	return conjunction
+}
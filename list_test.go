@@ -0,0 +1,51 @@
+package tinytranslator
+
+import "testing"
+
+func TestFormatList(t *testing.T) {
+	translator := NewTranslationEngine()
+	items := []string{D.Letters, D.Numbers, D.Space}
+
+	tests := []struct {
+		lang  string
+		items []string
+		style ListStyle
+		want  string
+	}{
+		{"en", items, ListAnd, "letters, numbers, and space"},
+		{"en", items, ListOr, "letters, numbers, or space"},
+		{"en", items[:2], ListAnd, "letters and numbers"},
+		{"en", items[:1], ListAnd, "letters"},
+		{"en", nil, ListAnd, ""},
+		{"es", items, ListAnd, "letras, números y espacio"},
+		{"es", items, ListOr, "letras, números o espacio"},
+		{"es", []string{"padre", "hijo"}, ListAnd, "padre e hijo"},
+		{"es", []string{"siete", "ocho"}, ListOr, "siete u ocho"},
+		{"es", []string{"agua", "hielo"}, ListAnd, "agua y hielo"},
+		{"fr", items, ListAnd, "lettres, nombres et espace"},
+		{"id", items, ListAnd, "surat, angka, dan ruang"},
+		{"ar", items, ListAnd, "رسائل وأرقام ومساحة"},
+		{"zh", items, ListAnd, "字母、数字和空间"},
+		{"en", []string{"", D.Letters, "", D.Numbers}, ListAnd, "letters and numbers"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.FormatList(tt.lang, tt.items, tt.style); got != tt.want {
+			t.Errorf("FormatList(%q, %v, %d) = %q; want %q", tt.lang, tt.items, tt.style, got, tt.want)
+		}
+	}
+}
+
+func TestListArguments(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	got := translator.T("es", D.Allowed, ':', And{D.Letters, D.Numbers, D.Space})
+	if want := "permitido: letras, números y espacio"; got != want {
+		t.Errorf("T() = %q; want %q", got, want)
+	}
+
+	got = translator.T("en", Or{D.Email, D.Phone})
+	if want := "email or phone"; got != want {
+		t.Errorf("T() = %q; want %q", got, want)
+	}
+}
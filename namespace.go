@@ -0,0 +1,84 @@
+package tinytranslator
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// LoadNamespace adds the entries of a dictionary struct under a namespace,
+// so that keys of large apps do not collide, eg: the field Total of a billing
+// namespace has the key "billing.total". Nested structs are namespaces too.
+// Languages declared by the namespace are added to the supported languages.
+//
+// ns must be a pointer to a struct of string fields, its fields are assigned
+// their keys like the fields of D. Loading a namespace already loaded replaces it.
+// Keys are snake case like those of D unless a KeyDerivation is given, eg:
+// DottedKey. Keys declared twice in the namespace return an error and leave
+// the translator and the fields of ns as they were before the call.
+// Like the other With methods it must not run concurrently with T().
+//
+// Example usage:
+//
+//	var Billing struct {
+//		Total   string `es:"total" fr:"total"`
+//		Invoice string `es:"factura" fr:"facture"`
+//	}
+//	translator.LoadNamespace("billing", &Billing)
+//	translator.T("es", Billing.Invoice) // "factura"
+func (l *Translator) LoadNamespace(name string, ns any, derive ...KeyDerivation) error {
+	if name == "" {
+		return l.Err(D.Dictionary, D.Name, D.Empty)
+	}
+	v := reflect.ValueOf(ns)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return l.Err(D.Dictionary, name, D.IsNotOfPointerType)
+	}
+	if v.Elem().Kind() != reflect.Struct {
+		return l.Err(D.Dictionary, name, D.IsNotOfStructureType)
+	}
+
+	// UnloadNamespace builds new slices, so the current ones and a copy of
+	// the fields restore a failed load
+	langSupported, translations, index := l.langSupported, l.translations, l.index
+	duplicates := l.duplicates
+	fields := reflect.New(v.Elem().Type()).Elem()
+	fields.Set(v.Elem())
+
+	l.UnloadNamespace(name)
+	l.addLanguages(v.Elem().Type())
+
+	deriveKey := SnakeKey
+	if len(derive) > 0 && derive[0] != nil {
+		deriveKey = derive[0]
+	}
+
+	loaded := len(l.duplicates)
+	l.addEntries(v.Elem(), name, name+".", deriveKey)
+	if len(l.duplicates) == loaded {
+		return nil
+	}
+
+	key := l.duplicates[loaded]
+	l.langSupported, l.translations, l.index = langSupported, translations, index
+	l.duplicates = duplicates
+	v.Elem().Set(fields)
+	return l.Err(D.Dictionary, name, D.DuplicateKey, ':', strconv.Quote(key))
+}
+
+// UnloadNamespace removes the entries of a namespace loaded by LoadNamespace.
+// Keys of the namespace are then written as is by T(). Languages added by the
+// namespace remain supported. Entries are matched by the namespace they were
+// loaded under, so "zip" never removes "address.zip.code" of another namespace.
+func (l *Translator) UnloadNamespace(name string) {
+	// New slices keep copies of the translator made before unloading intact
+	translations := make([]translation, 0, len(l.translations))
+	index := make(map[string]int, len(l.index))
+	for _, trans := range l.translations {
+		// The entries of D have no namespace and are never unloaded
+		if name == "" || trans.ns != name {
+			index[trans.Key] = len(translations)
+			translations = append(translations, trans)
+		}
+	}
+	l.translations, l.index = translations, index
+}
@@ -0,0 +1,183 @@
+package tinytranslator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadNamespace(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	var form struct {
+		Email string `es:"correo" fr:"courriel"`
+		Name  string `es:"nombre completo"`
+		Terms struct {
+			Accept string `es:"acepto" ja:"同意する"`
+		}
+	}
+
+	if err := translator.LoadNamespace("form", &form); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+
+	if form.Email != "form.email" || form.Terms.Accept != "form.terms.accept" {
+		t.Fatalf("namespace keys = %q, %q; want dotted keys", form.Email, form.Terms.Accept)
+	}
+
+	tests := []struct {
+		args []any
+		want string
+	}{
+		{[]any{"es", form.Email}, "correo"},
+		{[]any{"fr", form.Email}, "courriel"},
+		{[]any{"en", form.Email}, "email"},
+		{[]any{"es", form.Name, D.Name}, "nombre completo nombre"},
+		{[]any{"es", form.Terms.Accept}, "acepto"},
+		{[]any{"ja", form.Terms.Accept}, "同意する"},
+		// entries built before the namespace added ja fall back to english
+		{[]any{"ja", D.Name}, "name"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.T(tt.args...); got != tt.want {
+			t.Errorf("T(%q) = %q; want %q", tt.args, got, tt.want)
+		}
+	}
+
+	var reported bool
+	for _, m := range translator.MissingTranslations() {
+		if m.Key == "form.name" {
+			reported = true
+		}
+	}
+	if !reported {
+		t.Errorf("form.name not reported as missing")
+	}
+
+	translator.UnloadNamespace("form")
+	if got := translator.T("es", form.Email); got != "form.email" {
+		t.Errorf("after unload T(form.email) = %q; want key as is", got)
+	}
+	for _, m := range translator.MissingTranslations() {
+		if m.Key == "form.name" {
+			t.Errorf("form.name still reported after unload")
+		}
+	}
+	if got := translator.T("es", D.Name); got != "nombre" {
+		t.Errorf("after unload T(D.Name) = %q; want %q", got, "nombre")
+	}
+}
+
+func TestLoadNamespaceErrors(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	var billing struct{ Total string }
+	if err := translator.LoadNamespace("billing", billing); err == nil {
+		t.Errorf("expected error for a non pointer namespace")
+	}
+
+	name := "x"
+	if err := translator.LoadNamespace("billing", &name); err == nil {
+		t.Errorf("expected error for a non struct namespace")
+	}
+
+	var form struct{ X string }
+	if err := translator.LoadNamespace("", &form); err == nil || err.Error() != "dictionary name empty" {
+		t.Errorf("LoadNamespace with an empty name error = %v", err)
+	}
+	if form.X != "" {
+		t.Errorf("field assigned by a rejected namespace: %q", form.X)
+	}
+}
+
+func TestNamespaceKeyDerivation(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	var billing struct {
+		TotalAmount string `es:"importe total"`
+	}
+	if err := translator.LoadNamespace("billing", &billing, KebabKey); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+	if billing.TotalAmount != "billing.total-amount" {
+		t.Errorf("kebab namespace key = %q; want %q", billing.TotalAmount, "billing.total-amount")
+	}
+	if got := translator.T("es", billing.TotalAmount); got != "importe total" {
+		t.Errorf("T(billing.total-amount) = %q; want %q", got, "importe total")
+	}
+
+	// The keys of D are not affected by the derivation of a namespace
+	if D.ZipCode != "zip_code" {
+		t.Errorf("D.ZipCode = %q; want %q", D.ZipCode, "zip_code")
+	}
+}
+
+func TestNamespaceDottedKeys(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	var address struct {
+		ZipCode string `es:"código postal"`
+	}
+	if err := translator.LoadNamespace("address", &address, DottedKey); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+	if address.ZipCode != "address.zip.code" {
+		t.Errorf("dotted namespace key = %q; want %q", address.ZipCode, "address.zip.code")
+	}
+
+	var zip struct {
+		Prefix string `es:"prefijo"`
+	}
+	if err := translator.LoadNamespace("address.zip", &zip); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+
+	// Unloading a namespace only removes its own entries, whatever their keys
+	translator.UnloadNamespace("address.zip")
+	if got := translator.T("es", address.ZipCode); got != "código postal" {
+		t.Errorf("T(address.zip.code) after unloading address.zip = %q; want %q", got, "código postal")
+	}
+	if got := translator.T("es", zip.Prefix); got != zip.Prefix {
+		t.Errorf("T(address.zip.prefix) after unload = %q; want the key as is", got)
+	}
+
+	translator.UnloadNamespace("")
+	if got := translator.T("es", D.ZipCode); got != "código postal" {
+		t.Errorf("T(D.ZipCode) after unloading an empty namespace = %q; want %q", got, "código postal")
+	}
+}
+
+func TestNamespaceMissingTranslations(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	var fixture struct {
+		Nickname string `json:"nickname" es:"apodo" pt:"apelido" fr:"surnom" ru:"прозвище" de:"Spitzname" it:"soprannome" hi:"उपनाम" bn:"ডাকনাম" id:"nama panggilan" ar:"لقب" ur:"عرفیت"`
+		Age      string `validate:"required" db:"age" bun:"age" es:"edad" pt:"idade" fr:"âge" ru:"возраст" de:"Alter" it:"età" hi:"आयु" bn:"বয়স" id:"usia" ar:"عمر" ur:"عمر" zh:"年龄"`
+		Hobby    string `es:"pasatiempo" fr:"loisir" zh:"爱好" ar:"هواية" ur:"مشغلہ" id:"hobi" bn:"শখ" hi:"शौक" it:"hobby" de:"Hobby" ru:"хобби"`
+	}
+	if err := translator.LoadNamespace("fixture", &fixture); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+
+	var got []MissingTranslation
+	for _, m := range translator.MissingTranslations() {
+		if strings.HasPrefix(m.Key, "fixture.") {
+			got = append(got, m)
+		}
+	}
+	want := []MissingTranslation{
+		{Key: "fixture.nickname", Languages: []string{"zh"}},
+		{Key: "fixture.hobby", Languages: []string{"pt"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MissingTranslations() = %+v; want %+v", got, want)
+	}
+
+	for _, code := range translator.Languages() {
+		switch code {
+		case "json", "validate", "db", "bun":
+			t.Errorf("struct tag %q listed as a language", code)
+		}
+	}
+}
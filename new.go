@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
 )
 
 type writer interface {
@@ -17,24 +20,63 @@ func (d defaultWriter) Write(p []byte) (n int, err error) { return len(p), nil }
 
 // translation represents a single translation entry
 type translation struct {
-	Key    string   // Original snake case key
-	Values []string // Values in different languages
+	Key      string            // Original snake case key
+	Values   []string          // Values in different languages
+	Variants map[string]string // Variant values by tag, eg: "es_f" for the feminine form
+	ns       string            // namespace the entry was loaded under, empty for D
 }
 
 // language represents a supported language
 type language struct {
-	Code  string // eg: "en", "es"
-	Index int    // Index in the translations array
+	Code     string             // eg: "en", "es"
+	Index    int                // Index in the translations array
+	join     JoinRules          // Spacing and punctuation conventions
+	number   NumberFormat       // Decimal and grouping conventions
+	notation NotationFormat     // Percent and compact number patterns
+	date     DateFormat         // Date and time patterns
+	relative RelativeTimeFormat // Relative time and duration units
+	currency CurrencyFormat     // Currency symbol placement
+	list     ListFormat         // List conjunctions and separators
+}
+
+// newLanguage returns a language with the built-in conventions of its code
+func newLanguage(code string, index int) language {
+	return language{
+		Code:     code,
+		Index:    index,
+		join:     DefaultJoinRules(code),
+		number:   DefaultNumberFormat(code),
+		notation: DefaultNotationFormat(code),
+		date:     DefaultDateFormat(code),
+		relative: DefaultRelativeTimeFormat(code),
+		currency: DefaultCurrencyFormat(code),
+		list:     DefaultListFormat(code),
+	}
 }
 
 type Translator struct {
 	defaultLang   string
 	langSupported []language
 	translations  []translation
+	index         map[string]int // position of each key in translations
+	duplicates    []string
+	debug         *atomic.Int32 // DebugMode, shared by copies and switchable while translating
+	bidiIsolation bool
+	nowThreshold  time.Duration
+	measurement   MeasurementSystem
+	formality     Formality
 	err           errMessage
 	writer
 }
 
+// MissingTranslation reports a dictionary entry that has no value
+// for one or more of the supported languages
+type MissingTranslation struct {
+	Key       string   // snake case key of the entry, eg: "address"
+	Context   string   // context of the entry, empty when it has none
+	Languages []string // language codes without translation, eg: ["ru", "zh"]
+}
+
 type errMessage struct {
 	message string
 }
@@ -46,7 +88,8 @@ var D dictionary
 //
 // It analyzes a global dictionary structure to build translations and supported languages.
 // English is always included as the default language, and additional languages are
-// extracted from struct tags in the dictionary.
+// extracted from the struct tags of every field in the dictionary, in the order they
+// are first declared. Entries lacking a language are listed by MissingTranslations.
 //
 // Parameters:
 //   - params: Optional variadic parameters that can include:
@@ -73,79 +116,137 @@ var D dictionary
 func NewTranslationEngine(params ...any) *Translator {
 	// Define supported languages
 	supportedLangs := []language{
-		{Code: "en", Index: 0},
+		newLanguage("en", 0),
 	}
 
 	l := Translator{
 		defaultLang:   "en",
 		langSupported: supportedLangs,
 		translations:  make([]translation, 0, 100), // Pre-allocate space
+		index:         make(map[string]int, 100),
+		debug:         new(atomic.Int32),
 		err:           errMessage{message: ""},
 		writer:        defaultWriter{},
 	}
 
 	// Process dictionary tags to extract supported languages
 	v := reflect.ValueOf(&D).Elem()
-	t := v.Type()
+	l.addLanguages(v.Type())
+
+	// Process dictionary fields and build translations, the keys of D are
+	// always snake case so every translator assigns the same ones
+	l.addEntries(v, "", "", SnakeKey)
+
+	// Process variadic parameters
+	for _, param := range params {
+		switch v := param.(type) {
+		case string:
+			l.setDefaultLanguage(v)
+		case writer:
+			l.writer = v
+		}
+	}
+
+	return &l
+}
 
-	// Parse tags from first field to get language codes
-	if t.NumField() > 0 {
-		field := t.Field(0)
-		langTags := parseTag(field.Tag)
-
-		// Initialize language support
-		for langCode := range langTags {
-			if langCode != "en" { // "en" already added
-				l.langSupported = append(l.langSupported, language{
-					Code:  langCode,
-					Index: len(l.langSupported),
-				})
+// addLanguages collects language codes from the tags of every field, nested
+// structs included, keeping the order in which they are first declared so
+// that indexes are stable between runs
+func (l *Translator) addLanguages(t reflect.Type) {
+	for i := range t.NumField() {
+		if t.Field(i).Type.Kind() == reflect.Struct {
+			l.addLanguages(t.Field(i).Type)
+			continue
+		}
+		for _, pair := range parseTagPairs(t.Field(i).Tag) {
+			if !isLanguageTag(pair.key) {
+				continue
+			}
+			if l.findLanguageIndex(pair.key) < 0 {
+				l.langSupported = append(l.langSupported, newLanguage(pair.key, len(l.langSupported)))
 			}
 		}
 	}
+}
+
+// addEntries builds the translations of the string fields of a dictionary
+// struct loaded under the namespace ns and assigns their keys with derive.
+// Nested structs are namespaces whose keys are prefixed with the derived name
+// of the field, eg: "form.email".
+func (l *Translator) addEntries(v reflect.Value, ns, prefix string, derive KeyDerivation) {
+	t := v.Type()
 
-	// Process dictionary fields and build translations
 	for i := range v.NumField() {
 		field := v.Field(i)
 		dbFieldType := t.Field(i)
 
-		if field.CanSet() {
-			// Convert field name to: snake case
-			snakeCaseName := snakeCase(dbFieldType.Name)
+		if field.Kind() == reflect.Struct {
+			l.addEntries(field, ns, prefix+derive(dbFieldType.Name)+".", derive)
+			continue
+		}
+
+		if field.CanSet() && field.Kind() == reflect.String {
+			// Convert field name to: key, snake case by default
+			snakeCaseName := derive(dbFieldType.Name)
+			separateName := snakeCase(dbFieldType.Name, " ")
+
+			// Entries with context drop it from the name, eg: SpaceChar `ctx:"char"` is "space"
+			context := dbFieldType.Tag.Get(ctxTag)
+			if context != "" {
+				snakeCaseName = trimContext(snakeCaseName, derive(context))
+				separateName = trimContext(separateName, snakeCase(context, " "))
+			}
+
+			// Explicit key and english value, eg: `key:"api_response" en:"API response"`
+			if key := dbFieldType.Tag.Get(keyTag); key != "" {
+				snakeCaseName = key
+			}
+			if english := dbFieldType.Tag.Get("en"); english != "" {
+				separateName = english
+			}
+			snakeCaseName = prefix + snakeCaseName
+
 			// Assign field name to dictionary structure
-			field.SetString(snakeCaseName)
+			field.SetString(Ctx(context, snakeCaseName))
+
+			// The first entry of a key wins, the others are reported
+			if l.findKey(Ctx(context, snakeCaseName)) >= 0 {
+				l.duplicates = append(l.duplicates, Ctx(context, snakeCaseName))
+				continue
+			}
 
 			// Create new translation entry
 			trans := translation{
-				Key:    snakeCaseName,
+				Key:    Ctx(context, snakeCaseName),
 				Values: make([]string, len(l.langSupported)),
+				ns:     ns,
 			}
 
 			// Set default translation (English)
-			separateName := snakeCase(dbFieldType.Name, " ")
 			trans.Values[0] = separateName
 
 			// Add translations for other languages
 			for _, lang := range l.langSupported[1:] {
-				value := dbFieldType.Tag.Get(lang.Code)
-				trans.Values[lang.Index] = value
+				trans.Values[lang.Index] = dbFieldType.Tag.Get(lang.Code)
 			}
 
-			l.translations = append(l.translations, trans)
-		}
-	}
+			// Add variants of supported languages, eg: `es_f:"..."`
+			for _, pair := range parseTagPairs(dbFieldType.Tag) {
+				code, _, found := strings.Cut(pair.key, "_")
+				if !found || l.findLanguageIndex(code) < 0 {
+					continue
+				}
+				if trans.Variants == nil {
+					trans.Variants = make(map[string]string)
+				}
+				trans.Variants[pair.key] = pair.value
+			}
 
-	// Process variadic parameters
-	for _, param := range params {
-		switch v := param.(type) {
-		case string:
-			l.setDefaultLanguage(v)
-		case writer:
-			l.writer = v
+			l.index[trans.Key] = len(l.translations)
+			l.translations = append(l.translations, trans)
 		}
 	}
-
-	return &l
 }
 
 // WithCurrentDeviceLanguage sets the translator to use the system's current language.
@@ -181,6 +282,73 @@ func (l *Translator) WithCurrentDeviceLanguage() (*Translator, error) {
 	return l, nil
 }
 
+// Languages returns the codes of the supported languages in index order,
+// starting with English.
+func (l Translator) Languages() []string {
+	codes := make([]string, len(l.langSupported))
+	for i, lang := range l.langSupported {
+		codes[i] = lang.Code
+	}
+	return codes
+}
+
+// Keys returns the keys of the dictionary entries in dictionary order,
+// namespaces included. Keys qualified by a context are returned as built by Ctx.
+func (l Translator) Keys() []string {
+	keys := make([]string, len(l.translations))
+	for i, trans := range l.translations {
+		keys[i] = trans.Key
+	}
+	return keys
+}
+
+// MissingTranslations returns the dictionary entries that lack a translation
+// for one or more supported languages, in dictionary order. Languages added
+// by a namespace are missing in the entries loaded before it. Pseudo-locales
+// are not reported.
+//
+// Example usage:
+//
+//	for _, m := range translator.MissingTranslations() {
+//		println(m.Key, strings.Join(m.Languages, ","))
+//	}
+func (l Translator) MissingTranslations() []MissingTranslation {
+	var report []MissingTranslation
+	for _, trans := range l.translations {
+		var missing []string
+		for _, lang := range l.langSupported[1:] {
+			if isPseudoLocale(lang.Code) {
+				continue
+			}
+			// Entries built before a namespace added languages have fewer values
+			if lang.Index >= len(trans.Values) || trans.Values[lang.Index] == "" {
+				missing = append(missing, lang.Code)
+			}
+		}
+		if len(missing) > 0 {
+			context, key := SplitCtx(trans.Key)
+			report = append(report, MissingTranslation{Key: key, Context: context, Languages: missing})
+		}
+	}
+	return report
+}
+
+// DuplicateKeys returns the keys declared by more than one dictionary entry,
+// either derived from the field name or set with a key tag. Only the first
+// entry of a duplicated key is used.
+func (l Translator) DuplicateKeys() []string {
+	return l.duplicates
+}
+
+// findKey returns the index of the entry with the key, -1 when it does not exist
+func (l *Translator) findKey(key string) int {
+	// Copies of the translator made before an entry was added share the index
+	if i, ok := l.index[key]; ok && i < len(l.translations) {
+		return i
+	}
+	return -1
+}
+
 // setDefaultLanguage sets the default language
 func (l *Translator) setDefaultLanguage(language string) error {
 
@@ -194,10 +362,21 @@ func (l *Translator) setDefaultLanguage(language string) error {
 }
 
 // T returns the translation of the given arguments.
+//
+// Parts are joined with the spacing and punctuation rules of the target
+// language, see JoinRules. Rune arguments such as ':' or '?' are written
+// as punctuation marks of that language.
+//
+// A Gender argument selects the gender variant of the keys that follow it,
+// eg: T("es", D.Date, Feminine, D.NotValid) writes "fecha no es valida".
+// A Formality argument likewise overrides the register set by WithFormality.
+// Keys without that variant use their default value.
 func (l Translator) T(args ...any) string {
 
 	var out bytes.Buffer
 	var space string
+	var gender Gender
+	formality := l.formality
 
 	// Check if we have at least one argument
 	if len(args) == 0 {
@@ -215,6 +394,13 @@ func (l Translator) T(args ...any) string {
 		}
 	}
 
+	// Values inserted in right-to-left messages are isolated so that
+	// numbers, emails or errors do not reorder the surrounding text
+	isolate := l.bidiIsolation && IsRTL(l.langSupported[targetLangIndex].Code)
+
+	// Spacing and punctuation conventions of the target language
+	join := l.langSupported[targetLangIndex].join
+
 	// Process remaining arguments
 	for argNumber, arg := range args {
 		switch v := arg.(type) {
@@ -222,40 +408,82 @@ func (l Translator) T(args ...any) string {
 			if v == "" {
 				continue
 			}
-			out.WriteString(space + l.findTranslation(v, targetLangIndex))
+			out.WriteString(space + l.translateValue(v, targetLangIndex, isolate, variants(formality, gender)...))
 		case []string:
 			for _, s := range v {
 				if s == "" {
 					continue
 				}
-				out.WriteString(space + l.findTranslation(s, targetLangIndex))
-				space = " "
+				out.WriteString(space + l.translateValue(s, targetLangIndex, isolate, variants(formality, gender)...))
+				space = join.Separator
 			}
-		// Other cases remain the same
+		case Gender:
+			gender = v
+			continue
+		case Formality:
+			formality = v
+			continue
+		case Ordinal:
+			out.WriteString(space + isolateIf(isolate, l.formatOrdinal(targetLangIndex, v.N, v.Gender)))
+		case Spelled:
+			out.WriteString(space + l.spellOut(targetLangIndex, v.N, v.Gender))
+		case Percent:
+			out.WriteString(space + isolateIf(isolate, l.formatPercent(targetLangIndex, v.Value, v.precision(), v.Rounding)))
+		case Compact:
+			out.WriteString(space + isolateIf(isolate, l.formatCompact(targetLangIndex, v.Value, v.precision(), v.Rounding)))
+		case Measure:
+			out.WriteString(space + isolateIf(isolate, l.formatMeasure(targetLangIndex, v.Value, v.Unit, v.Style)))
+		case ByteSize:
+			out.WriteString(space + isolateIf(isolate, l.formatBytes(targetLangIndex, v.N, v.Binary)))
+		case And:
+			out.WriteString(space + l.formatList(targetLangIndex, v, ListAnd, isolate))
+		case Or:
+			out.WriteString(space + l.formatList(targetLangIndex, v, ListOr, isolate))
 		case rune:
-			if v == ':' {
-				out.WriteString(":")
+			if p, ok := join.Punctuation[v]; ok {
+				if out.Len() > 0 {
+					out.WriteString(p.Before)
+				}
+				out.WriteString(p.Mark)
+				space = p.After
 				continue
 			}
 			out.WriteString(space + string(v))
-		case int:
-			out.WriteString(space + strconv.Itoa(v))
-		case float64:
-			out.WriteString(space + strconv.FormatFloat(v, 'f', -1, 64))
+		case int, int8, int16, int64, uint, uint8, uint16, uint32, uint64, float32, float64, Number:
+			number, _ := l.langSupported[targetLangIndex].number.formatNumberArg(v)
+			out.WriteString(space + isolateIf(isolate, number))
+		case time.Duration:
+			out.WriteString(space + isolateIf(isolate, l.FormatDuration(l.langSupported[targetLangIndex].Code, v)))
+		case time.Time:
+			out.WriteString(space + isolateIf(isolate, l.FormatDate(l.langSupported[targetLangIndex].Code, v, DateLong)))
 		case bool:
-			out.WriteString(space + strconv.FormatBool(v))
+			out.WriteString(space + isolateIf(isolate, strconv.FormatBool(v)))
 		case error:
-			out.WriteString(space + v.Error())
+			out.WriteString(space + isolateIf(isolate, v.Error()))
 		default:
 			out.WriteString(space + l.findTranslation("argument", targetLangIndex) +
 				": " + strconv.Itoa(argNumber) + " " +
 				l.findTranslation("unknown", targetLangIndex))
 		}
-		space = " "
+		space = join.Separator
 	}
 	return out.String()
 }
 
+// translateValue returns the translation of a dictionary key, or the value
+// itself when it is not a key, isolated if requested.
+func (l *Translator) translateValue(v string, langIndex int, isolate bool, variants ...string) string {
+	text, from := l.resolve(v, langIndex, variants...)
+	if mode := DebugMode(l.debug.Load()); mode != DebugOff {
+		return l.debugText(mode, v, text, from, langIndex)
+	}
+	// English text taken as fallback inside a right-to-left message is isolated too
+	if from == langIndex || from >= 0 && IsRTL(l.langSupported[from].Code) {
+		return text
+	}
+	return isolateIf(isolate, text)
+}
+
 func (l Translator) Err(args ...any) error {
 	l.err.message = l.T(args...)
 	return l.err
@@ -283,18 +511,61 @@ func (l *Translator) findLanguageIndex(code string) int {
 
 // findTranslation returns the translation for a key in the specified language
 func (l *Translator) findTranslation(key string, langIndex int) string {
-	for _, trans := range l.translations {
-		if trans.Key == key {
-			if langIndex >= 0 && langIndex < len(trans.Values) {
-				if trans.Values[langIndex] != "" {
-					return trans.Values[langIndex]
+	value, _ := l.lookup(key, langIndex)
+	return value
+}
+
+// lookup returns the translation for a key in the specified language and
+// whether the key exists in the dictionary. Unknown keys are returned as is.
+func (l *Translator) lookup(key string, langIndex int, variants ...string) (string, bool) {
+	text, from := l.resolve(key, langIndex, variants...)
+	return text, from >= 0
+}
+
+// resolve returns the translation for a key in the specified language and the
+// index of the language it was taken from, -1 when the key does not exist.
+// The first variant found, eg: "f" or "formal", is preferred to the default value.
+// Keys qualified by a context without entry fall back to the key without context.
+func (l *Translator) resolve(key string, langIndex int, variants ...string) (string, int) {
+	// Pseudo-locales transform the english value
+	if langIndex > 0 && langIndex < len(l.langSupported) && isPseudoLocale(l.langSupported[langIndex].Code) {
+		text, from := l.resolve(key, 0, variants...)
+		if from < 0 {
+			return text, from
+		}
+		return pseudoLocalize(l.langSupported[langIndex].Code, text), langIndex
+	}
+
+	if i := l.findKey(key); i >= 0 {
+		trans := l.translations[i]
+		if langIndex >= 0 && langIndex < len(l.langSupported) {
+			for _, variant := range variants {
+				if variant == "" {
+					continue
 				}
-				// Fallback to default language if translation is empty
-				defIndex := l.findLanguageIndex(l.defaultLang)
-				return trans.Values[defIndex]
+				if value := trans.Variants[l.langSupported[langIndex].Code+"_"+variant]; value != "" {
+					return value, langIndex
+				}
+			}
+			// Entries built before a namespace added languages have fewer values
+			if langIndex < len(trans.Values) && trans.Values[langIndex] != "" {
+				return trans.Values[langIndex], langIndex
+			}
+			// Fallback to default language if translation is empty
+			if defIndex := l.findLanguageIndex(l.defaultLang); defIndex < len(trans.Values) {
+				return trans.Values[defIndex], defIndex
 			}
-			break
+			return trans.Values[0], 0
 		}
 	}
-	return key
+	if context, plain := SplitCtx(key); context != "" {
+		return l.resolve(plain, langIndex, variants...)
+	}
+	return key, -1
+}
+
+// isVariantTag reports whether a tag key names a variant of a language,
+// eg: "es_f", rather than a language code
+func isVariantTag(key string) bool {
+	return strings.Contains(key, "_")
 }
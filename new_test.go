@@ -1,6 +1,8 @@
 package tinytranslator_test
 
 import (
+	"reflect"
+	"strings"
 	"sync"
 	"testing"
 
@@ -134,3 +136,136 @@ func TestConcurrentIndividualInstances(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestLanguagesDeterministicOrder(t *testing.T) {
+	want := []string{"en", "es", "pt", "fr", "ru", "de", "it", "hi", "bn", "id", "ar", "ur", "zh"}
+
+	for range 10 {
+		got := NewTranslationEngine().Languages()
+		if len(got) != len(want) {
+			t.Fatalf("Languages() = %v; want %v", got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("Languages() = %v; want %v", got, want)
+			}
+		}
+	}
+}
+
+func TestMissingTranslations(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	for _, m := range translator.MissingTranslations() {
+		if m.Key == "" || len(m.Languages) == 0 {
+			t.Errorf("invalid missing translation report: %+v", m)
+		}
+		for _, code := range m.Languages {
+			if code == "en" {
+				t.Errorf("english reported as missing for %q", m.Key)
+			}
+		}
+	}
+}
+
+func TestKeyAndEnglishOverrides(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	var api struct {
+		APIResponse string `key:"api_response" en:"API response" es:"respuesta de la API"`
+		Email       string `en:"E-mail address" es:"correo electrónico"`
+		Brand       string `en:"TinyTranslator"`
+	}
+	if err := translator.LoadNamespace("api", &api); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+
+	if api.APIResponse != "api.api_response" {
+		t.Errorf("key override = %q; want %q", api.APIResponse, "api.api_response")
+	}
+
+	tests := []struct {
+		args []any
+		want string
+	}{
+		{[]any{"en", api.APIResponse}, "API response"},
+		{[]any{"es", api.APIResponse}, "respuesta de la API"},
+		{[]any{"en", api.Email}, "E-mail address"},
+		{[]any{"es", api.Brand}, "TinyTranslator"},
+	}
+	for _, tt := range tests {
+		if got := translator.T(tt.args...); got != tt.want {
+			t.Errorf("T(%q) = %q; want %q", tt.args, got, tt.want)
+		}
+	}
+
+	for _, code := range translator.Languages() {
+		if code == "key" {
+			t.Errorf("key tag listed as a language")
+		}
+	}
+}
+
+func TestDuplicateKeys(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	if got := translator.DuplicateKeys(); len(got) != 0 {
+		t.Errorf("built-in dictionary has duplicate keys: %v", got)
+	}
+
+	var form struct {
+		UserName string `es:"usuario"`
+	}
+	if err := translator.LoadNamespace("form", &form); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+	keys, languages := len(translator.Keys()), len(translator.Languages())
+
+	var duplicated struct {
+		UserName string `es:"usuario" ko:"사용자"`
+		Login    string `key:"user_name" es:"acceso"`
+		Nickname string `es:"apodo"`
+	}
+	err := translator.LoadNamespace("form", &duplicated)
+	if err == nil || err.Error() != `dictionary form duplicate key: "form.user_name"` {
+		t.Errorf("LoadNamespace duplicate error = %v", err)
+	}
+
+	// A failed load leaves the translator unchanged
+	if got := translator.DuplicateKeys(); len(got) != 0 {
+		t.Errorf("DuplicateKeys() after failed load = %v; want none", got)
+	}
+	if got := len(translator.Keys()); got != keys {
+		t.Errorf("Keys() after failed load has %d keys; want %d", got, keys)
+	}
+	if got := len(translator.Languages()); got != languages {
+		t.Errorf("Languages() after failed load has %d languages; want %d", got, languages)
+	}
+	if got := translator.T("es", form.UserName); got != "usuario" {
+		t.Errorf("T(form.user_name) after failed load = %q; want %q", got, "usuario")
+	}
+	if duplicated.UserName != "" || duplicated.Login != "" || duplicated.Nickname != "" {
+		t.Errorf("fields assigned after failed load: %+v", duplicated)
+	}
+	if got := translator.T("es", "form.nickname"); got != "form.nickname" {
+		t.Errorf("T(form.nickname) after failed load = %q; want the key as is", got)
+	}
+}
+
+func TestDictionaryKeys(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	// Dots separate namespaces, a dotted key in D would collide with them
+	for _, key := range translator.Keys() {
+		if _, plain := SplitCtx(key); strings.Contains(plain, ".") {
+			t.Errorf("dictionary key %q contains the namespace separator", plain)
+		}
+	}
+
+	v := reflect.ValueOf(D)
+	for i := range v.NumField() {
+		if v.Field(i).String() == "" {
+			t.Errorf("dictionary field %s has no key", v.Type().Field(i).Name)
+		}
+	}
+}
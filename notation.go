@@ -0,0 +1,257 @@
+package tinytranslator
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode selects how values are rounded to the requested precision
+type RoundingMode int
+
+const (
+	RoundHalfUp   RoundingMode = iota // halves away from zero, eg: 2.5 -> 3
+	RoundHalfEven                     // halves to the even neighbour, eg: 2.5 -> 2
+	RoundDown                         // toward zero, eg: 2.9 -> 2
+)
+
+// CompactStep abbreviates numbers from Magnitude up to the next step,
+// eg: {1e6, "{0}M"} writes 1200000 as "1.2M"
+type CompactStep struct {
+	Magnitude float64
+	Pattern   string // "{0}" is replaced by the scaled number
+}
+
+// NotationFormat defines how percents and compact numbers are written in a language
+type NotationFormat struct {
+	Percent string        // percent pattern, eg: "{0}%" or "{0} %"
+	Compact []CompactStep // abbreviations in ascending magnitude
+}
+
+// Percent wraps a ratio so T() writes it as a percent, eg: 0.45 as "45%".
+// Precision is the number of decimals, -1 for the minimum needed. It only
+// applies when Fixed is set, otherwise the minimum needed is written.
+//
+// Example usage:
+//
+//	translator.T("de", D.Value, Percent{Value: 0.45})                              // "Wert 45 %"
+//	translator.T("en", D.Value, Percent{Value: 0.4567, Precision: 1, Fixed: true}) // "value 45.7%"
+type Percent struct {
+	Value     float64
+	Precision int
+	Fixed     bool // use Precision instead of the minimum needed
+	Rounding  RoundingMode
+}
+
+// Compact wraps a number so T() writes it in compact notation, eg: "1.2K".
+// Precision is the maximum number of decimals, -1 for one decimal below ten
+// and none above, eg: "1.2K", "12K", "123K". It only applies when Fixed is
+// set, otherwise -1 is used.
+//
+// Example usage:
+//
+//	translator.T("zh", Compact{Value: 12000})                              // "1.2万"
+//	translator.T("en", Compact{Value: 1234567, Precision: 2, Fixed: true}) // "1.23M"
+type Compact struct {
+	Value     float64
+	Precision int
+	Fixed     bool // use Precision instead of -1
+	Rounding  RoundingMode
+}
+
+// precision returns the decimals of a percent, -1 unless Fixed is set
+func (p Percent) precision() int {
+	if p.Fixed {
+		return p.Precision
+	}
+	return -1
+}
+
+// precision returns the decimals of a compact number, -1 unless Fixed is set
+func (c Compact) precision() int {
+	if c.Fixed {
+		return c.Precision
+	}
+	return -1
+}
+
+// DefaultNotationFormat returns the built-in percent and compact notation of
+// a language code. Languages without specific conventions use the english ones.
+func DefaultNotationFormat(code string) NotationFormat {
+	nt := NotationFormat{Percent: "{0}%"}
+
+	switch code {
+	case "es", "de", "ru":
+		nt.Percent = "{0}" + nbsp + "%"
+	case "fr":
+		nt.Percent = "{0}" + narrowNbsp + "%"
+	case "ar":
+		nt.Percent = "٪{0}"
+	}
+
+	steps := func(magnitude float64, patterns ...string) []CompactStep {
+		out := make([]CompactStep, len(patterns))
+		for i, p := range patterns {
+			out[i] = CompactStep{Magnitude: magnitude, Pattern: p}
+			magnitude *= 1000
+		}
+		return out
+	}
+
+	switch code {
+	case "es":
+		nt.Compact = steps(1e3, "{0}"+nbsp+"mil", "{0}"+nbsp+"M", "{0}"+nbsp+"mil"+nbsp+"M", "{0}"+nbsp+"B")
+	case "pt":
+		nt.Compact = steps(1e3, "{0}"+nbsp+"mil", "{0}"+nbsp+"mi", "{0}"+nbsp+"bi", "{0}"+nbsp+"tri")
+	case "fr":
+		nt.Compact = steps(1e3, "{0}"+nbsp+"k", "{0}"+nbsp+"M", "{0}"+nbsp+"Md", "{0}"+nbsp+"Bn")
+	case "ru":
+		nt.Compact = steps(1e3, "{0}"+nbsp+"тыс.", "{0}"+nbsp+"млн", "{0}"+nbsp+"млрд", "{0}"+nbsp+"трлн")
+	case "de":
+		nt.Compact = steps(1e6, "{0}"+nbsp+"Mio.", "{0}"+nbsp+"Mrd.", "{0}"+nbsp+"Bio.")
+	case "it":
+		nt.Compact = steps(1e6, "{0}"+nbsp+"Mln", "{0}"+nbsp+"Mrd", "{0}"+nbsp+"Bln")
+	case "id":
+		nt.Compact = steps(1e3, "{0}"+nbsp+"rb", "{0}"+nbsp+"jt", "{0}"+nbsp+"M", "{0}"+nbsp+"T")
+	case "ar":
+		nt.Compact = steps(1e3, "{0}"+nbsp+"ألف", "{0}"+nbsp+"مليون", "{0}"+nbsp+"مليار", "{0}"+nbsp+"ترليون")
+	case "hi":
+		nt.Compact = []CompactStep{
+			{1e3, "{0}" + nbsp + "हज़ार"}, {1e5, "{0}" + nbsp + "लाख"}, {1e7, "{0}" + nbsp + "क॰"},
+			{1e9, "{0}" + nbsp + "अ॰"}, {1e11, "{0}" + nbsp + "ख॰"},
+		}
+	case "bn":
+		nt.Compact = []CompactStep{{1e3, "{0}" + nbsp + "হা"}, {1e5, "{0}" + nbsp + "লা"}, {1e7, "{0}" + nbsp + "কো"}}
+	case "ur":
+		nt.Compact = []CompactStep{
+			{1e3, "{0}" + nbsp + "ہزار"}, {1e5, "{0}" + nbsp + "لاکھ"}, {1e7, "{0}" + nbsp + "کروڑ"},
+			{1e9, "{0}" + nbsp + "ارب"}, {1e11, "{0}" + nbsp + "کھرب"},
+		}
+	case "zh":
+		nt.Compact = []CompactStep{{1e4, "{0}万"}, {1e8, "{0}亿"}, {1e12, "{0}万亿"}}
+	default:
+		nt.Compact = steps(1e3, "{0}K", "{0}M", "{0}B", "{0}T")
+	}
+
+	return nt
+}
+
+// WithNotationFormat overrides the percent and compact notation used for a
+// language. Unsupported language codes are ignored.
+func (l *Translator) WithNotationFormat(code string, nt NotationFormat) *Translator {
+	if i := l.findLanguageIndex(code); i >= 0 {
+		l.langSupported[i].notation = nt
+	}
+	return l
+}
+
+// FormatPercent writes a ratio as a percent in the given language, rounded to
+// precision decimals (-1 for the minimum needed). An empty or unsupported
+// language uses the default language.
+//
+// Example usage:
+//
+//	translator.FormatPercent("en", 0.45, 0, RoundHalfUp)    // "45%"
+//	translator.FormatPercent("fr", 0.4567, 1, RoundHalfUp)  // "45,7 %"
+//	translator.FormatPercent("ar", 0.45, 0, RoundHalfUp)    // "٪45"
+func (l Translator) FormatPercent(lang string, value float64, precision int, mode RoundingMode) string {
+	return l.formatPercent(l.langIndex(lang), value, precision, mode)
+}
+
+// FormatCompact writes a number in the compact notation of the given language,
+// rounded to at most precision decimals (-1 for one decimal below ten and
+// none above, eg: "1.2K", "12K", "123K").
+// Numbers below the first abbreviation are written in full.
+//
+// Example usage:
+//
+//	translator.FormatCompact("en", 1234, -1, RoundHalfUp)    // "1.2K"
+//	translator.FormatCompact("zh", 12000, -1, RoundHalfUp)   // "1.2万"
+//	translator.FormatCompact("hi", 1200000, -1, RoundHalfUp) // "12 लाख"
+func (l Translator) FormatCompact(lang string, value float64, precision int, mode RoundingMode) string {
+	return l.formatCompact(l.langIndex(lang), value, precision, mode)
+}
+
+// formatPercent writes a percent in the language at idx
+func (l *Translator) formatPercent(idx int, value float64, precision int, mode RoundingMode) string {
+	conv := l.langSupported[idx]
+	nf := conv.number
+	nf.Precision = precision
+
+	number := nf.formatFloat(roundTo(value*100, precision, mode))
+	return strings.ReplaceAll(conv.notation.Percent, "{0}", number)
+}
+
+// formatCompact writes a number in compact notation in the language at idx
+func (l *Translator) formatCompact(idx int, value float64, precision int, mode RoundingMode) string {
+	conv := l.langSupported[idx]
+	steps := conv.notation.Compact
+
+	step := -1
+	for i, s := range steps {
+		if math.Abs(value) >= s.Magnitude {
+			step = i
+		}
+	}
+
+	for {
+		scaled := value
+		if step >= 0 {
+			scaled /= steps[step].Magnitude
+		}
+
+		digits := precision
+		if digits < 0 {
+			digits = 0
+			if math.Abs(scaled) < 10 && step >= 0 {
+				digits = 1
+			}
+		}
+		scaled = roundTo(scaled, digits, mode)
+
+		// Rounding may reach the next abbreviation, eg: 999999 is "1M" and not "1000K"
+		if step+1 < len(steps) {
+			next := steps[step+1].Magnitude
+			if step >= 0 {
+				next /= steps[step].Magnitude
+			}
+			if math.Abs(scaled) >= next {
+				step++
+				continue
+			}
+		}
+
+		nf := conv.number
+		nf.Precision = -1
+		if step < 0 {
+			return nf.formatFloat(scaled)
+		}
+		return strings.ReplaceAll(steps[step].Pattern, "{0}", nf.formatFloat(scaled))
+	}
+}
+
+// roundTo rounds a value to the given decimals, a negative precision only drops binary noise
+func roundTo(v float64, precision int, mode RoundingMode) float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+
+	// Keeping 15 significant digits drops binary noise, eg: 0.29*100 = 28.999999999999996
+	v, _ = strconv.ParseFloat(strconv.FormatFloat(v, 'g', 15, 64), 64)
+	if precision < 0 {
+		return v
+	}
+
+	p := math.Pow(10, float64(precision))
+	x, _ := strconv.ParseFloat(strconv.FormatFloat(v*p, 'g', 15, 64), 64)
+
+	switch mode {
+	case RoundHalfEven:
+		x = math.RoundToEven(x)
+	case RoundDown:
+		x = math.Trunc(x)
+	default:
+		x = math.Round(x)
+	}
+	return x / p
+}
@@ -0,0 +1,105 @@
+package tinytranslator
+
+import "testing"
+
+func TestFormatPercent(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang      string
+		value     float64
+		precision int
+		mode      RoundingMode
+		want      string
+	}{
+		{"en", 0.45, 0, RoundHalfUp, "45%"},
+		{"de", 0.45, 0, RoundHalfUp, "45" + nbsp + "%"},
+		{"fr", 0.4567, 1, RoundHalfUp, "45,7" + narrowNbsp + "%"},
+		{"ar", 0.45, 0, RoundHalfUp, "٪45"},
+		{"en", 0.29, -1, RoundHalfUp, "29%"},
+		{"en", 0.29, 0, RoundDown, "29%"},
+		{"en", 0.125, 0, RoundHalfEven, "12%"},
+		{"en", 0.125, 0, RoundHalfUp, "13%"},
+		{"en", 0.999, 0, RoundDown, "99%"},
+		{"en", 12.5, 0, RoundHalfUp, "1,250%"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.FormatPercent(tt.lang, tt.value, tt.precision, tt.mode); got != tt.want {
+			t.Errorf("FormatPercent(%q, %v, %d, %v) = %q; want %q", tt.lang, tt.value, tt.precision, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestFormatCompact(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang      string
+		value     float64
+		precision int
+		mode      RoundingMode
+		want      string
+	}{
+		{"en", 999, -1, RoundHalfUp, "999"},
+		{"en", 1234, -1, RoundHalfUp, "1.2K"},
+		{"en", 1000, -1, RoundHalfUp, "1K"},
+		{"en", 12345, -1, RoundHalfUp, "12K"},
+		{"en", 10000, -1, RoundHalfUp, "10K"},
+		{"en", 99500, -1, RoundHalfUp, "100K"},
+		{"en", 123456, -1, RoundHalfUp, "123K"},
+		{"en", 999499, -1, RoundHalfUp, "999K"},
+		{"en", 12345, 1, RoundHalfUp, "12.3K"},
+		{"en", 1234567, 2, RoundHalfUp, "1.23M"},
+		{"en", -1234, -1, RoundHalfUp, "-1.2K"},
+		{"en", 999999, -1, RoundHalfUp, "1M"},
+		{"en", 999999, -1, RoundDown, "999K"},
+		{"en", 1250, -1, RoundHalfEven, "1.2K"},
+		{"fr", 1234, -1, RoundHalfUp, "1,2" + nbsp + "k"},
+		{"es", 1234, -1, RoundHalfUp, "1,2" + nbsp + "mil"},
+		{"de", 1234, -1, RoundHalfUp, "1.234"},
+		{"de", 1500000, -1, RoundHalfUp, "1,5" + nbsp + "Mio."},
+		{"zh", 12000, -1, RoundHalfUp, "1.2万"},
+		{"zh", 9999, -1, RoundHalfUp, "9,999"},
+		{"zh", 300000000, -1, RoundHalfUp, "3亿"},
+		{"hi", 1200000, -1, RoundHalfUp, "12" + nbsp + "लाख"},
+		{"ru", 2500000, -1, RoundHalfUp, "2,5" + nbsp + "млн"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.FormatCompact(tt.lang, tt.value, tt.precision, tt.mode); got != tt.want {
+			t.Errorf("FormatCompact(%q, %v, %d, %v) = %q; want %q", tt.lang, tt.value, tt.precision, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestNotationArguments(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	got := translator.T("de", D.Value, Percent{Value: 0.45})
+	if want := "Wert 45" + nbsp + "%"; got != want {
+		t.Errorf("T(Percent) = %q; want %q", got, want)
+	}
+
+	got = translator.T("zh", Compact{Value: 12000})
+	if want := "1.2万"; got != want {
+		t.Errorf("T(Compact) = %q; want %q", got, want)
+	}
+
+	// The zero Precision only applies when Fixed is set
+	tests := []struct {
+		arg  any
+		want string
+	}{
+		{Percent{Value: 0.4567}, "45.67%"},
+		{Percent{Value: 0.4567, Fixed: true}, "46%"},
+		{Compact{Value: 1234}, "1.2K"},
+		{Compact{Value: 1234, Fixed: true}, "1K"},
+		{Compact{Value: 1234567, Precision: 2, Fixed: true}, "1.23M"},
+	}
+	for _, tt := range tests {
+		if got := translator.T("en", tt.arg); got != tt.want {
+			t.Errorf("T(%+v) = %q; want %q", tt.arg, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package tinytranslator
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// NumberFormat defines how numbers are written in a language
+type NumberFormat struct {
+	Decimal        string // decimal separator, eg: "." or ","
+	Group          string // grouping separator, eg: "," or "."
+	PrimaryGroup   int    // size of the first group from the right, eg: 3
+	SecondaryGroup int    // size of the following groups, eg: 2 for lakh grouping, 0 same as primary
+	MinGrouping    int    // minimum digits in the leading group to apply grouping, eg: 2 in es "1234"
+	Precision      int    // digits after the decimal separator, -1 for the minimum needed
+	Digits         string // the ten digits 0-9 of the numbering system, empty for ASCII digits
+}
+
+// Number wraps a numeric value so T() formats it with a fixed precision.
+// Precision only applies when Fixed is set, otherwise the precision of the
+// language format is used.
+//
+// Example usage:
+//
+//	translator.T("de", D.Value, Number{Value: 1234.5, Precision: 2, Fixed: true}) // "Wert 1.234,50"
+//	translator.T("de", D.Value, Number{Value: 1234.5})                            // "Wert 1.234,5"
+type Number struct {
+	Value     float64
+	Precision int  // digits after the decimal separator, -1 for the minimum needed
+	Fixed     bool // use Precision instead of the precision of the language
+}
+
+// DefaultNumberFormat returns the built-in number format of a language code.
+// Languages without specific conventions use the english ones.
+//
+// Example usage:
+//
+//	nf := DefaultNumberFormat("de")
+//	nf.Precision = 2
+//	translator := NewTranslationEngine().WithNumberFormat("de", nf)
+func DefaultNumberFormat(code string) NumberFormat {
+	nf := NumberFormat{
+		Decimal:      ".",
+		Group:        ",",
+		PrimaryGroup: 3,
+		MinGrouping:  1,
+		Precision:    -1,
+	}
+
+	switch code {
+	case "es":
+		nf.Decimal, nf.Group, nf.MinGrouping = ",", ".", 2
+	case "pt", "de", "it", "id":
+		nf.Decimal, nf.Group = ",", "."
+	case "fr":
+		nf.Decimal, nf.Group = ",", narrowNbsp
+	case "ru":
+		nf.Decimal, nf.Group = ",", nbsp
+	case "hi", "bn":
+		nf.SecondaryGroup = 2
+	}
+
+	return nf
+}
+
+// WithNumberFormat overrides the number format used for a language.
+// Unsupported language codes are ignored.
+func (l *Translator) WithNumberFormat(code string, nf NumberFormat) *Translator {
+	if i := l.findLanguageIndex(code); i >= 0 {
+		l.langSupported[i].number = nf
+	}
+	return l
+}
+
+// FormatNumber formats a value with the conventions of the given language.
+// An empty or unsupported language uses the default language. A precision
+// below zero uses the precision of the language format.
+//
+// Example usage:
+//
+//	translator.FormatNumber("de", 1234.5, -1)   // "1.234,5"
+//	translator.FormatNumber("hi", 1234567, 2)   // "12,34,567.00"
+func (l Translator) FormatNumber(lang string, value float64, precision int) string {
+	nf := l.langSupported[l.langIndex(lang)].number
+	if precision >= 0 {
+		nf.Precision = precision
+	}
+	return nf.formatFloat(value)
+}
+
+// langIndex returns the index of a language, or the index of the default
+// language when code is empty or not supported
+func (l *Translator) langIndex(code string) int {
+	if code != "" {
+		if i := l.findLanguageIndex(code); i >= 0 {
+			return i
+		}
+	}
+	return l.findLanguageIndex(l.defaultLang)
+}
+
+// formatNumberArg formats the numeric arguments accepted by T(). The second
+// result is false when the value is not numeric.
+func (nf NumberFormat) formatNumberArg(arg any) (string, bool) {
+	switch v := arg.(type) {
+	case int:
+		return nf.formatInt(int64(v)), true
+	case int8:
+		return nf.formatInt(int64(v)), true
+	case int16:
+		return nf.formatInt(int64(v)), true
+	case int64:
+		return nf.formatInt(v), true
+	case uint:
+		return nf.formatUint(uint64(v)), true
+	case uint8:
+		return nf.formatUint(uint64(v)), true
+	case uint16:
+		return nf.formatUint(uint64(v)), true
+	case uint32:
+		return nf.formatUint(uint64(v)), true
+	case uint64:
+		return nf.formatUint(v), true
+	case float32:
+		return nf.formatFloatBits(float64(v), 32), true
+	case float64:
+		return nf.formatFloat(v), true
+	case Number:
+		if v.Fixed {
+			nf.Precision = v.Precision
+		}
+		return nf.formatFloat(v.Value), true
+	}
+	return "", false
+}
+
+// formatInt formats a signed integer
+func (nf NumberFormat) formatInt(v int64) string {
+	if v < 0 {
+		// uint64 conversion keeps math.MinInt64 representable
+		return "-" + nf.formatUint(uint64(-(v+1))+1)
+	}
+	return nf.formatUint(uint64(v))
+}
+
+// formatUint formats an unsigned integer
+func (nf NumberFormat) formatUint(v uint64) string {
+	return nf.shape(nf.group(strconv.FormatUint(v, 10)))
+}
+
+// formatFloat formats a floating point number
+func (nf NumberFormat) formatFloat(v float64) string {
+	return nf.formatFloatBits(v, 64)
+}
+
+// formatFloatBits formats a floating point number that was originally of
+// bitSize bits, so float32 values print their shortest float32 form
+func (nf NumberFormat) formatFloatBits(v float64, bitSize int) string {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return strconv.FormatFloat(v, 'f', -1, bitSize)
+	}
+
+	s := strconv.FormatFloat(v, 'f', nf.Precision, bitSize)
+
+	var sign string
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(s, ".")
+	out := sign + nf.group(integer)
+	if hasFraction {
+		out += nf.Decimal + fraction
+	}
+	return nf.shape(out)
+}
+
+// group inserts grouping separators in a string of ASCII digits
+func (nf NumberFormat) group(digits string) string {
+	primary := nf.PrimaryGroup
+	if primary <= 0 || nf.Group == "" || len(digits) < primary+max(nf.MinGrouping, 1) {
+		return digits
+	}
+	secondary := nf.SecondaryGroup
+	if secondary <= 0 {
+		secondary = primary
+	}
+
+	// split from the right: first the primary group, then secondary groups
+	end := len(digits) - primary
+	groups := []string{digits[end:]}
+	for end > 0 {
+		start := max(end-secondary, 0)
+		groups = append(groups, digits[start:end])
+		end = start
+	}
+
+	var b strings.Builder
+	for i := len(groups) - 1; i >= 0; i-- {
+		b.WriteString(groups[i])
+		if i > 0 {
+			b.WriteString(nf.Group)
+		}
+	}
+	return b.String()
+}
+
+// shape replaces ASCII digits with the digits of the numbering system
+func (nf NumberFormat) shape(s string) string {
+	if nf.Digits == "" {
+		return s
+	}
+	digits := []rune(nf.Digits)
+	if len(digits) != 10 {
+		return s
+	}
+
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(digits[r-'0'])
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
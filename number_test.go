@@ -0,0 +1,79 @@
+package tinytranslator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFormatNumber(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang      string
+		value     float64
+		precision int
+		want      string
+	}{
+		{"en", 1234.5, -1, "1,234.5"},
+		{"de", 1234.5, -1, "1.234,5"},
+		{"fr", 1234.5, -1, "1" + narrowNbsp + "234,5"},
+		{"ru", 1234567, -1, "1" + nbsp + "234" + nbsp + "567"},
+		{"es", 1234.5, -1, "1234,5"},
+		{"es", 12345.5, -1, "12.345,5"},
+		{"hi", 1234567, -1, "12,34,567"},
+		{"hi", 1234.5, -1, "1,234.5"},
+		{"en", -1234567.891, 2, "-1,234,567.89"},
+		{"de", 0.5, 2, "0,50"},
+		{"en", 999, -1, "999"},
+		{"", 1234, -1, "1,234"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.FormatNumber(tt.lang, tt.value, tt.precision); got != tt.want {
+			t.Errorf("FormatNumber(%q, %v, %d) = %q; want %q", tt.lang, tt.value, tt.precision, got, tt.want)
+		}
+	}
+}
+
+func TestNumberArguments(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		name string
+		args []any
+		want string
+	}{
+		{"int en", []any{"en", D.Value, 1234567}, "value 1,234,567"},
+		{"float de", []any{"de", 1234.5}, "1.234,5"},
+		{"int64 min", []any{"en", int64(math.MinInt64)}, "-9,223,372,036,854,775,808"},
+		{"uint64", []any{"en", uint64(18446744073709551615)}, "18,446,744,073,709,551,615"},
+		{"fixed precision", []any{"it", Number{Value: 1234.5, Precision: 2, Fixed: true}}, "1.234,50"},
+		{"fixed zero precision", []any{"en", Number{Value: 1234.5, Fixed: true}}, "1,234"},
+		{"language precision", []any{"en", Number{Value: 1234.5}}, "1,234.5"},
+		{"precision without fixed", []any{"en", Number{Value: 1234.5, Precision: 2}}, "1,234.5"},
+		{"float32 shortest", []any{"es", float32(0.1)}, "0,1"},
+		{"float32 grouped", []any{"en", float32(1234.75)}, "1,234.75"},
+		{"small int", []any{"de", 42}, "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := translator.T(tt.args...); got != tt.want {
+				t.Errorf("T() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithNumberFormat(t *testing.T) {
+	nf := DefaultNumberFormat("en")
+	nf.Precision = 2
+	nf.Digits = "٠١٢٣٤٥٦٧٨٩"
+
+	translator := NewTranslationEngine().WithNumberFormat("ar", nf)
+
+	want := "١,٢٣٤.٥٠"
+	if got := translator.T("ar", 1234.5); got != want {
+		t.Errorf("T() = %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,166 @@
+package tinytranslator
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// spaceGroups are the separators accepted when a language groups digits with spaces
+const spaceGroups = " " + nbsp + narrowNbsp + "\u2009"
+
+// bidiMarks are invisible direction marks ignored when parsing numbers
+const bidiMarks = "\u200e\u200f\u061c" + rli + fsi + pdi
+
+// ParseNumber parses a number written with the decimal and grouping separators
+// of the given language, in any recognized digit system. Grouping is optional
+// but when present it must follow the conventions of the language. An empty or
+// unsupported language uses the default language.
+//
+// On failure it returns a translated error with D.NotNumber or D.OutOfRange.
+//
+// Example usage:
+//
+//	translator.ParseNumber("de", "1.234,56")  // 1234.56, nil
+//	translator.ParseNumber("fr", "1 234,56")  // 1234.56, nil
+//	translator.ParseNumber("hi", "१२,३४,५६७") // 1234567, nil
+//	translator.ParseNumber("en", "1.234,56")  // 0, "value 1.234,56 not number"
+func (l Translator) ParseNumber(lang, input string) (float64, error) {
+	conv := l.langSupported[l.langIndex(lang)]
+
+	s, ok := conv.number.normalize(input)
+	if !ok {
+		return 0, l.Err(conv.Code, D.Value, input, D.NotNumber)
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, l.Err(conv.Code, D.Value, input, D.OutOfRange)
+	} else if err != nil {
+		return 0, l.Err(conv.Code, D.Value, input, D.NotNumber)
+	}
+	return v, nil
+}
+
+// ParseInteger parses a whole number like ParseNumber. Values with decimals are
+// rejected with D.NotNumber and values beyond int64 with D.OutOfRange.
+//
+// Example usage:
+//
+//	translator.ParseInteger("es", "12.345") // 12345, nil
+func (l Translator) ParseInteger(lang, input string) (int64, error) {
+	conv := l.langSupported[l.langIndex(lang)]
+
+	s, ok := conv.number.normalize(input)
+	if !ok || strings.Contains(s, ".") {
+		return 0, l.Err(conv.Code, D.Value, input, D.NotNumber)
+	}
+
+	v, err := strconv.ParseInt(s, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, l.Err(conv.Code, D.Value, input, D.OutOfRange)
+	} else if err != nil {
+		return 0, l.Err(conv.Code, D.Value, input, D.NotNumber)
+	}
+	return v, nil
+}
+
+// normalize rewrites a localized number with ASCII digits, no grouping and
+// "." as decimal separator, eg: "1.234,5" in de is "1234.5". The second
+// result is false when the input is not a valid number in this format.
+func (nf NumberFormat) normalize(input string) (string, bool) {
+	s := strings.TrimSpace(strings.Map(func(r rune) rune {
+		if strings.ContainsRune(bidiMarks, r) {
+			return -1
+		}
+		return r
+	}, input))
+
+	var sign string
+	if rest, ok := strings.CutPrefix(s, "-"); ok {
+		sign, s = "-", rest
+	} else if rest, ok := strings.CutPrefix(s, "−"); ok {
+		sign, s = "-", rest
+	} else if rest, ok := strings.CutPrefix(s, "+"); ok {
+		s = rest
+	}
+
+	var groups []string
+	var current, fraction strings.Builder
+	decimal := false
+
+	for _, r := range s {
+		switch {
+		case digitToASCII(r) >= '0' && digitToASCII(r) <= '9':
+			if decimal {
+				fraction.WriteRune(digitToASCII(r))
+			} else {
+				current.WriteRune(digitToASCII(r))
+			}
+		case !decimal && nf.isDecimal(r):
+			decimal = true
+		case !decimal && nf.isGroup(r):
+			groups = append(groups, current.String())
+			current.Reset()
+		default:
+			return "", false
+		}
+	}
+	groups = append(groups, current.String())
+
+	if !nf.validGroups(groups) || (decimal && fraction.Len() == 0) {
+		return "", false
+	}
+
+	integer := strings.Join(groups, "")
+	if integer == "" && !decimal {
+		return "", false
+	}
+	if integer == "" {
+		integer = "0"
+	}
+	if decimal {
+		return sign + integer + "." + fraction.String(), true
+	}
+	return sign + integer, true
+}
+
+// isDecimal reports whether r is the decimal separator, the arabic "٫" is always accepted
+func (nf NumberFormat) isDecimal(r rune) bool {
+	return string(r) == nf.Decimal || r == '٫'
+}
+
+// isGroup reports whether r is the grouping separator, the arabic "٬" is always
+// accepted and any space when the language groups with spaces
+func (nf NumberFormat) isGroup(r rune) bool {
+	if string(r) == nf.Group || r == '٬' {
+		return true
+	}
+	return strings.Contains(spaceGroups, nf.Group) && strings.ContainsRune(spaceGroups, r)
+}
+
+// validGroups reports whether the digit groups follow the grouping sizes,
+// eg: ["12", "34", "567"] with lakh grouping
+func (nf NumberFormat) validGroups(groups []string) bool {
+	if len(groups) == 1 {
+		return true
+	}
+
+	secondary := nf.SecondaryGroup
+	if secondary <= 0 {
+		secondary = nf.PrimaryGroup
+	}
+
+	last := len(groups) - 1
+	for i, g := range groups {
+		switch {
+		case i == last && len(g) != nf.PrimaryGroup:
+			return false
+		case i == 0 && (len(g) == 0 || len(g) > secondary):
+			return false
+		case i > 0 && i < last && len(g) != secondary:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+package tinytranslator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseNumber(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang  string
+		input string
+		want  float64
+	}{
+		{"en", "1,234.56", 1234.56},
+		{"en", "1234.56", 1234.56},
+		{"de", "1.234,56", 1234.56},
+		{"es", "-1.234,5", -1234.5},
+		{"fr", "1 234,56", 1234.56},
+		{"fr", "1" + narrowNbsp + "234,56", 1234.56},
+		{"ru", "1" + nbsp + "234 567", 1234567},
+		{"hi", "12,34,567", 1234567},
+		{"hi", "१२,३४,५६७", 1234567},
+		{"ar", "٢٬٠٢٤٫٥", 2024.5},
+		{"ar", "\u200f-15", -15},
+		{"de", ",5", 0.5},
+		{"en", " +42 ", 42},
+		{"en", "−7", -7},
+	}
+
+	for _, tt := range tests {
+		got, err := translator.ParseNumber(tt.lang, tt.input)
+		if err != nil {
+			t.Errorf("ParseNumber(%q, %q) error: %v", tt.lang, tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseNumber(%q, %q) = %v; want %v", tt.lang, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseNumberErrors(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang  string
+		input string
+		want  string
+	}{
+		{"en", "1.234,56", "value 1.234,56 not number"},
+		{"de", "1,234.56", "Wert 1,234.56 ist keine Zahl"},
+		{"en", "12,34", "value 12,34 not number"},
+		{"hi", "1,234,567", "मूल्य 1,234,567 यह एक संख्या नहीं है"},
+		{"en", "", "value not number"},
+		{"en", "1.", "value 1. not number"},
+		{"en", "abc", "value abc not number"},
+		{"en", "1e999", "value 1e999 not number"},
+	}
+
+	for _, tt := range tests {
+		_, err := translator.ParseNumber(tt.lang, tt.input)
+		if err == nil {
+			t.Errorf("ParseNumber(%q, %q) expected error", tt.lang, tt.input)
+			continue
+		}
+		if err.Error() != tt.want {
+			t.Errorf("ParseNumber(%q, %q) error = %q; want %q", tt.lang, tt.input, err.Error(), tt.want)
+		}
+	}
+}
+
+func TestParseInteger(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	if got, err := translator.ParseInteger("es", "12.345"); err != nil || got != 12345 {
+		t.Errorf("ParseInteger(es, 12.345) = %v, %v; want 12345", got, err)
+	}
+
+	_, err := translator.ParseInteger("en", "1.5")
+	if err == nil || err.Error() != "value 1.5 not number" {
+		t.Errorf("ParseInteger(en, 1.5) error = %v", err)
+	}
+
+	huge := strings.Repeat("9", 400)
+	_, err = translator.ParseNumber("en", huge)
+	if err == nil || err.Error() != "value "+huge+" out of range" {
+		t.Errorf("ParseNumber out of range error = %v", err)
+	}
+
+	_, err = translator.ParseInteger("en", "99,999,999,999,999,999,999")
+	if err == nil || err.Error() != "value 99,999,999,999,999,999,999 out of range" {
+		t.Errorf("ParseInteger out of range error = %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package tinytranslator
+
+// Ordinal wraps a number so T() writes it as an ordinal, eg: "1st", "1.º", "1er".
+//
+// Example usage:
+//
+//	translator.T("es", Ordinal{N: 1, Gender: Feminine}) // "1.ª"
+type Ordinal struct {
+	N      int64
+	Gender Gender
+}
+
+// FormatOrdinal writes n as an ordinal number in the given language. The gender
+// selects the suffix in languages where it agrees with the noun (es, pt, it,
+// fr, ru, ur). An empty or unsupported language uses the default language.
+//
+// Example usage:
+//
+//	translator.FormatOrdinal("en", 2, Neutral)  // "2nd"
+//	translator.FormatOrdinal("es", 1, Neutral)  // "1.º"
+//	translator.FormatOrdinal("fr", 1, Feminine) // "1re"
+//	translator.FormatOrdinal("zh", 3, Neutral)  // "第3"
+func (l Translator) FormatOrdinal(lang string, n int64, g Gender) string {
+	return l.formatOrdinal(l.langIndex(lang), n, g)
+}
+
+// formatOrdinal writes n as an ordinal number in the language at idx
+func (l *Translator) formatOrdinal(idx int, n int64, g Gender) string {
+	conv := l.langSupported[idx]
+	num := conv.number.formatInt(n)
+	feminine := g == Feminine
+
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+
+	switch conv.Code {
+	case "es", "pt":
+		if feminine {
+			return num + ".ª"
+		}
+		return num + ".º"
+	case "it":
+		if feminine {
+			return num + "ª"
+		}
+		return num + "º"
+	case "fr":
+		if abs == 1 {
+			if feminine {
+				return num + "re"
+			}
+			return num + "er"
+		}
+		return num + "e"
+	case "de", "ar":
+		return num + "."
+	case "ru":
+		if feminine {
+			return num + "-я"
+		}
+		return num + "-й"
+	case "hi":
+		switch abs {
+		case 1:
+			return num + "ला"
+		case 2, 3:
+			return num + "रा"
+		case 4:
+			return num + "था"
+		case 6:
+			return num + "ठा"
+		}
+		return num + "वाँ"
+	case "bn":
+		switch abs {
+		case 1, 5, 7, 8, 9, 10:
+			return num + "ম"
+		case 2, 3:
+			return num + "য়"
+		case 4:
+			return num + "র্থ"
+		case 6:
+			return num + "ষ্ঠ"
+		}
+		return num + "তম"
+	case "id":
+		return "ke-" + num
+	case "ur":
+		if feminine {
+			return num + "ویں"
+		}
+		return num + "واں"
+	case "zh":
+		return "第" + num
+	}
+
+	// english suffixes: 1st 2nd 3rd 4th ... 11th 12th 13th ... 21st
+	suffix := "th"
+	if abs%100 < 11 || abs%100 > 13 {
+		switch abs % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return num + suffix
+}
@@ -0,0 +1,90 @@
+package tinytranslator
+
+import "math"
+
+// PluralCategory is a CLDR plural category used to select the form of a word
+// that depends on a number, eg: "1 day" (PluralOne) and "3 days" (PluralOther)
+type PluralCategory int
+
+const (
+	PluralZero PluralCategory = iota
+	PluralOne
+	PluralTwo
+	PluralFew
+	PluralMany
+	PluralOther
+)
+
+// PluralForms holds the forms of a word indexed by PluralCategory. The
+// placeholder "{0}" is replaced by the number. Empty forms use PluralOther.
+//
+// Example:
+//
+//	PluralForms{PluralOne: "{0} day", PluralOther: "{0} days"}
+type PluralForms [6]string
+
+// form returns the form of the given category, falling back to PluralOther
+func (p PluralForms) form(c PluralCategory) string {
+	if p[c] != "" {
+		return p[c]
+	}
+	return p[PluralOther]
+}
+
+// Plural returns the plural category of a number in a language
+//
+// Example usage:
+//
+//	Plural("ru", 3)  // PluralFew
+//	Plural("ru", 5)  // PluralMany
+//	Plural("fr", 0)  // PluralOne
+//	Plural("en", 1.5) // PluralOther
+func Plural(code string, n float64) PluralCategory {
+	n = math.Abs(n)
+	i := int64(n)
+	fraction := n != math.Trunc(n)
+
+	switch code {
+	case "en", "de", "it", "es", "ur":
+		if i == 1 && !fraction {
+			return PluralOne
+		}
+	case "fr", "pt":
+		if i == 0 || i == 1 {
+			return PluralOne
+		}
+	case "hi", "bn":
+		if i == 0 || n == 1 {
+			return PluralOne
+		}
+	case "ru":
+		if fraction {
+			return PluralOther
+		}
+		switch mod10, mod100 := i%10, i%100; {
+		case mod10 == 1 && mod100 != 11:
+			return PluralOne
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return PluralFew
+		default:
+			return PluralMany
+		}
+	case "ar":
+		if fraction {
+			return PluralOther
+		}
+		switch mod100 := i % 100; {
+		case i == 0:
+			return PluralZero
+		case i == 1:
+			return PluralOne
+		case i == 2:
+			return PluralTwo
+		case mod100 >= 3 && mod100 <= 10:
+			return PluralFew
+		case mod100 >= 11 && mod100 <= 99:
+			return PluralMany
+		}
+	}
+	return PluralOther
+}
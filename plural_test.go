@@ -0,0 +1,40 @@
+package tinytranslator
+
+import "testing"
+
+func TestPlural(t *testing.T) {
+	tests := []struct {
+		code string
+		n    float64
+		want PluralCategory
+	}{
+		{"en", 1, PluralOne},
+		{"en", 0, PluralOther},
+		{"en", 1.5, PluralOther},
+		{"es", 2, PluralOther},
+		{"fr", 0, PluralOne},
+		{"fr", 1.5, PluralOne},
+		{"fr", 2, PluralOther},
+		{"hi", 0, PluralOne},
+		{"ru", 1, PluralOne},
+		{"ru", 21, PluralOne},
+		{"ru", 11, PluralMany},
+		{"ru", 3, PluralFew},
+		{"ru", 13, PluralMany},
+		{"ru", 5, PluralMany},
+		{"ru", 1.5, PluralOther},
+		{"ar", 0, PluralZero},
+		{"ar", 2, PluralTwo},
+		{"ar", 105, PluralFew},
+		{"ar", 11, PluralMany},
+		{"ar", 100, PluralOther},
+		{"zh", 1, PluralOther},
+		{"id", 1, PluralOther},
+	}
+
+	for _, tt := range tests {
+		if got := Plural(tt.code, tt.n); got != tt.want {
+			t.Errorf("Plural(%q, %v) = %d; want %d", tt.code, tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package tinytranslator
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Pseudo-locale codes, as used by Windows for pseudo-localization
+const (
+	PseudoLocale    = "qps-ploc"  // accented and expanded english, eg: "[ļåñĝûåĝé~~]"
+	PseudoLocaleRTL = "qps-plocm" // the same text displayed right to left
+)
+
+// Unicode directional formatting characters that reverse the display of a text
+const (
+	rlo = "\u202e" // RIGHT-TO-LEFT OVERRIDE
+	pdf = "\u202c" // POP DIRECTIONAL FORMATTING
+)
+
+// pseudoAccents replaces each ASCII letter with an accented look-alike
+var pseudoAccents = strings.NewReplacer(
+	"A", "Å", "a", "å", "B", "Ɓ", "b", "ƀ", "C", "Ç", "c", "ç", "D", "Ð", "d", "ð",
+	"E", "É", "e", "é", "F", "Ƒ", "f", "ƒ", "G", "Ĝ", "g", "ĝ", "H", "Ĥ", "h", "ĥ",
+	"I", "Î", "i", "î", "J", "Ĵ", "j", "ĵ", "K", "Ķ", "k", "ķ", "L", "Ļ", "l", "ļ",
+	"M", "Ṁ", "m", "ṁ", "N", "Ñ", "n", "ñ", "O", "Ö", "o", "ö", "P", "Þ", "p", "þ",
+	"Q", "Ǫ", "q", "ǫ", "R", "Ŕ", "r", "ŕ", "S", "Š", "s", "š", "T", "Ţ", "t", "ţ",
+	"U", "Û", "u", "û", "V", "Ṽ", "v", "ṽ", "W", "Ŵ", "w", "ŵ", "X", "Ẋ", "x", "ẋ",
+	"Y", "Ý", "y", "ý", "Z", "Ž", "z", "ž",
+)
+
+// WithPseudoLocales adds the pseudo-locales PseudoLocale and PseudoLocaleRTL.
+// They write the english value of every dictionary entry accented, about 30%
+// longer and between brackets, so that untranslated strings, which are left as
+// is, and truncated texts stand out without real translations. PseudoLocaleRTL
+// is a right-to-left language displaying the same text reversed.
+//
+// Example usage:
+//
+//	translator := NewTranslationEngine().WithPseudoLocales()
+//	translator.T(PseudoLocale, D.Language, "Go") // "[ļåñĝûåĝé~~] Go"
+func (l *Translator) WithPseudoLocales() *Translator {
+	for _, code := range []string{PseudoLocale, PseudoLocaleRTL} {
+		if l.findLanguageIndex(code) < 0 {
+			l.langSupported = append(l.langSupported, newLanguage(code, len(l.langSupported)))
+		}
+	}
+	return l
+}
+
+// isPseudoLocale reports whether the language code is a pseudo-locale
+func isPseudoLocale(code string) bool {
+	return code == PseudoLocale || code == PseudoLocaleRTL
+}
+
+// pseudoLocalize transforms an english text for a pseudo-locale
+func pseudoLocalize(code, text string) string {
+	padding := utf8.RuneCountInString(text) * 3 / 10
+	if padding < 1 {
+		padding = 1
+	}
+	text = pseudoAccents.Replace(text) + strings.Repeat("~", padding)
+
+	if code == PseudoLocaleRTL {
+		return "[" + rlo + text + pdf + "]"
+	}
+	return "[" + text + "]"
+}
@@ -0,0 +1,41 @@
+package tinytranslator
+
+import "testing"
+
+func TestPseudoLocales(t *testing.T) {
+	translator := NewTranslationEngine("es").WithPseudoLocales()
+
+	tests := []struct {
+		args []any
+		want string
+	}{
+		{[]any{PseudoLocale, D.Language}, "[ļåñĝûåĝé~~]"},
+		{[]any{PseudoLocale, D.Language, "Go"}, "[ļåñĝûåĝé~~] Go"},
+		{[]any{PseudoLocale, D.In}, "[îñ~]"},
+		{[]any{PseudoLocale, D.Value, ':', 1234.5}, "[ṽåļûé~]: 1,234.5"},
+		{[]any{PseudoLocaleRTL, D.Language}, "[" + rlo + "ļåñĝûåĝé~~" + pdf + "]"},
+		{[]any{D.Language}, "idioma"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.T(tt.args...); got != tt.want {
+			t.Errorf("T(%q) = %q; want %q", tt.args, got, tt.want)
+		}
+	}
+
+	if !IsRTL(PseudoLocaleRTL) || IsRTL(PseudoLocale) {
+		t.Errorf("IsRTL pseudo-locales = %v, %v; want true, false", IsRTL(PseudoLocaleRTL), IsRTL(PseudoLocale))
+	}
+
+	// Languages are added once
+	translator.WithPseudoLocales()
+	count := 0
+	for _, code := range translator.Languages() {
+		if isPseudoLocale(code) {
+			count++
+		}
+	}
+	if count != 2 {
+		t.Errorf("pseudo-locales listed %d times; want 2", count)
+	}
+}
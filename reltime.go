@@ -0,0 +1,347 @@
+package tinytranslator
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// TimeUnit is a unit used by relative time and duration formatting
+type TimeUnit int
+
+const (
+	Second TimeUnit = iota
+	Minute
+	Hour
+	Day
+	Week
+	Month
+	Year
+)
+
+// defaultNowThreshold is the offset below which FormatRelativeTime writes "now"
+const defaultNowThreshold = 10 * time.Second
+
+// RelativeTimeFormat defines how relative times and durations are written in a language
+type RelativeTimeFormat struct {
+	Now      string         // eg: "now"
+	Past     string         // eg: "{0} ago"
+	Future   string         // eg: "in {0}"
+	Units    [7]PluralForms // duration forms indexed by TimeUnit, eg: "{0} days"
+	Relative [7]PluralForms // forms used inside Past and Future, empty to use Units
+}
+
+// pf returns plural forms for languages that only distinguish one and other
+func pf(one, other string) PluralForms {
+	return PluralForms{PluralOne: one, PluralOther: other}
+}
+
+// DefaultRelativeTimeFormat returns the built-in relative time format of a
+// language code. Languages without specific conventions use the english ones.
+func DefaultRelativeTimeFormat(code string) RelativeTimeFormat {
+	switch code {
+	case "es":
+		return RelativeTimeFormat{
+			Now: "ahora", Past: "hace {0}", Future: "dentro de {0}",
+			Units: [7]PluralForms{
+				pf("{0} segundo", "{0} segundos"), pf("{0} minuto", "{0} minutos"),
+				pf("{0} hora", "{0} horas"), pf("{0} día", "{0} días"),
+				pf("{0} semana", "{0} semanas"), pf("{0} mes", "{0} meses"),
+				pf("{0} año", "{0} años"),
+			},
+		}
+	case "pt":
+		return RelativeTimeFormat{
+			Now: "agora", Past: "há {0}", Future: "em {0}",
+			Units: [7]PluralForms{
+				pf("{0} segundo", "{0} segundos"), pf("{0} minuto", "{0} minutos"),
+				pf("{0} hora", "{0} horas"), pf("{0} dia", "{0} dias"),
+				pf("{0} semana", "{0} semanas"), pf("{0} mês", "{0} meses"),
+				pf("{0} ano", "{0} anos"),
+			},
+		}
+	case "fr":
+		return RelativeTimeFormat{
+			Now: "maintenant", Past: "il y a {0}", Future: "dans {0}",
+			Units: [7]PluralForms{
+				pf("{0} seconde", "{0} secondes"), pf("{0} minute", "{0} minutes"),
+				pf("{0} heure", "{0} heures"), pf("{0} jour", "{0} jours"),
+				pf("{0} semaine", "{0} semaines"), pf("{0} mois", "{0} mois"),
+				pf("{0} an", "{0} ans"),
+			},
+		}
+	case "ru":
+		ru := func(one, few, many string) PluralForms {
+			return PluralForms{PluralOne: one, PluralFew: few, PluralMany: many, PluralOther: few}
+		}
+		return RelativeTimeFormat{
+			Now: "сейчас", Past: "{0} назад", Future: "через {0}",
+			Units: [7]PluralForms{
+				ru("{0} секунда", "{0} секунды", "{0} секунд"), ru("{0} минута", "{0} минуты", "{0} минут"),
+				ru("{0} час", "{0} часа", "{0} часов"), ru("{0} день", "{0} дня", "{0} дней"),
+				ru("{0} неделя", "{0} недели", "{0} недель"), ru("{0} месяц", "{0} месяца", "{0} месяцев"),
+				ru("{0} год", "{0} года", "{0} лет"),
+			},
+			// accusative case after "через" and before "назад"
+			Relative: [7]PluralForms{
+				Second: ru("{0} секунду", "{0} секунды", "{0} секунд"),
+				Minute: ru("{0} минуту", "{0} минуты", "{0} минут"),
+				Week:   ru("{0} неделю", "{0} недели", "{0} недель"),
+			},
+		}
+	case "de":
+		return RelativeTimeFormat{
+			Now: "jetzt", Past: "vor {0}", Future: "in {0}",
+			Units: [7]PluralForms{
+				pf("{0} Sekunde", "{0} Sekunden"), pf("{0} Minute", "{0} Minuten"),
+				pf("{0} Stunde", "{0} Stunden"), pf("{0} Tag", "{0} Tage"),
+				pf("{0} Woche", "{0} Wochen"), pf("{0} Monat", "{0} Monate"),
+				pf("{0} Jahr", "{0} Jahre"),
+			},
+			// dative case after "in" and "vor"
+			Relative: [7]PluralForms{
+				Day:   pf("{0} Tag", "{0} Tagen"),
+				Month: pf("{0} Monat", "{0} Monaten"),
+				Year:  pf("{0} Jahr", "{0} Jahren"),
+			},
+		}
+	case "it":
+		return RelativeTimeFormat{
+			Now: "ora", Past: "{0} fa", Future: "tra {0}",
+			Units: [7]PluralForms{
+				pf("{0} secondo", "{0} secondi"), pf("{0} minuto", "{0} minuti"),
+				pf("{0} ora", "{0} ore"), pf("{0} giorno", "{0} giorni"),
+				pf("{0} settimana", "{0} settimane"), pf("{0} mese", "{0} mesi"),
+				pf("{0} anno", "{0} anni"),
+			},
+		}
+	case "hi":
+		return RelativeTimeFormat{
+			Now: "अब", Past: "{0} पहले", Future: "{0} में",
+			Units: [7]PluralForms{
+				pf("{0} सेकंड", "{0} सेकंड"), pf("{0} मिनट", "{0} मिनट"),
+				pf("{0} घंटा", "{0} घंटे"), pf("{0} दिन", "{0} दिन"),
+				pf("{0} सप्ताह", "{0} सप्ताह"), pf("{0} महीना", "{0} महीने"),
+				pf("{0} वर्ष", "{0} वर्ष"),
+			},
+		}
+	case "bn":
+		return RelativeTimeFormat{
+			Now: "এখন", Past: "{0} আগে", Future: "{0} পরে",
+			Units: [7]PluralForms{
+				pf("", "{0} সেকেন্ড"), pf("", "{0} মিনিট"),
+				pf("", "{0} ঘন্টা"), pf("", "{0} দিন"),
+				pf("", "{0} সপ্তাহ"), pf("", "{0} মাস"),
+				pf("", "{0} বছর"),
+			},
+		}
+	case "id":
+		return RelativeTimeFormat{
+			Now: "sekarang", Past: "{0} yang lalu", Future: "dalam {0}",
+			Units: [7]PluralForms{
+				pf("", "{0} detik"), pf("", "{0} menit"),
+				pf("", "{0} jam"), pf("", "{0} hari"),
+				pf("", "{0} minggu"), pf("", "{0} bulan"),
+				pf("", "{0} tahun"),
+			},
+		}
+	case "ar":
+		ar := func(zero, one, two, few, many string) PluralForms {
+			return PluralForms{zero, one, two, few, many, zero}
+		}
+		return RelativeTimeFormat{
+			Now: "الآن", Past: "قبل {0}", Future: "خلال {0}",
+			Units: [7]PluralForms{
+				ar("{0} ثانية", "ثانية واحدة", "ثانيتان", "{0} ثوانٍ", "{0} ثانية"),
+				ar("{0} دقيقة", "دقيقة واحدة", "دقيقتان", "{0} دقائق", "{0} دقيقة"),
+				ar("{0} ساعة", "ساعة واحدة", "ساعتان", "{0} ساعات", "{0} ساعة"),
+				ar("{0} يوم", "يوم واحد", "يومان", "{0} أيام", "{0} يومًا"),
+				ar("{0} أسبوع", "أسبوع واحد", "أسبوعان", "{0} أسابيع", "{0} أسبوعًا"),
+				ar("{0} شهر", "شهر واحد", "شهران", "{0} أشهر", "{0} شهرًا"),
+				ar("{0} سنة", "سنة واحدة", "سنتان", "{0} سنوات", "{0} سنة"),
+			},
+			// genitive dual after "قبل" and "خلال"
+			Relative: [7]PluralForms{
+				ar("{0} ثانية", "ثانية واحدة", "ثانيتين", "{0} ثوانٍ", "{0} ثانية"),
+				ar("{0} دقيقة", "دقيقة واحدة", "دقيقتين", "{0} دقائق", "{0} دقيقة"),
+				ar("{0} ساعة", "ساعة واحدة", "ساعتين", "{0} ساعات", "{0} ساعة"),
+				ar("{0} يوم", "يوم واحد", "يومين", "{0} أيام", "{0} يومًا"),
+				ar("{0} أسبوع", "أسبوع واحد", "أسبوعين", "{0} أسابيع", "{0} أسبوعًا"),
+				ar("{0} شهر", "شهر واحد", "شهرين", "{0} أشهر", "{0} شهرًا"),
+				ar("{0} سنة", "سنة واحدة", "سنتين", "{0} سنوات", "{0} سنة"),
+			},
+		}
+	case "ur":
+		return RelativeTimeFormat{
+			Now: "اب", Past: "{0} پہلے", Future: "{0} میں",
+			Units: [7]PluralForms{
+				pf("{0} سیکنڈ", "{0} سیکنڈ"), pf("{0} منٹ", "{0} منٹ"),
+				pf("{0} گھنٹہ", "{0} گھنٹے"), pf("{0} دن", "{0} دن"),
+				pf("{0} ہفتہ", "{0} ہفتے"), pf("{0} مہینہ", "{0} مہینے"),
+				pf("{0} سال", "{0} سال"),
+			},
+		}
+	case "zh":
+		return RelativeTimeFormat{
+			Now: "现在", Past: "{0}前", Future: "{0}后",
+			Units: [7]PluralForms{
+				pf("", "{0}秒"), pf("", "{0}分钟"),
+				pf("", "{0}小时"), pf("", "{0}天"),
+				pf("", "{0}周"), pf("", "{0}个月"),
+				pf("", "{0}年"),
+			},
+		}
+	}
+
+	return RelativeTimeFormat{
+		Now: "now", Past: "{0} ago", Future: "in {0}",
+		Units: [7]PluralForms{
+			pf("{0} second", "{0} seconds"), pf("{0} minute", "{0} minutes"),
+			pf("{0} hour", "{0} hours"), pf("{0} day", "{0} days"),
+			pf("{0} week", "{0} weeks"), pf("{0} month", "{0} months"),
+			pf("{0} year", "{0} years"),
+		},
+	}
+}
+
+// WithRelativeTimeFormat overrides the relative time format used for a language.
+// Unsupported language codes are ignored.
+func (l *Translator) WithRelativeTimeFormat(code string, rf RelativeTimeFormat) *Translator {
+	if i := l.findLanguageIndex(code); i >= 0 {
+		l.langSupported[i].relative = rf
+	}
+	return l
+}
+
+// WithNowThreshold sets the offset below which FormatRelativeTime writes "now".
+// The default threshold is 10 seconds, zero restores it and a negative
+// threshold disables "now" so every offset is written with its unit.
+func (l *Translator) WithNowThreshold(d time.Duration) *Translator {
+	l.nowThreshold = d
+	return l
+}
+
+// FormatRelativeTime formats an offset from the present moment in the given
+// language. Negative offsets are in the past and positive ones in the future.
+// The largest unit that fits is used. An empty or unsupported language uses
+// the default language.
+//
+// Example usage:
+//
+//	translator.FormatRelativeTime("en", -72*time.Hour)  // "3 days ago"
+//	translator.FormatRelativeTime("es", 2*time.Hour)    // "dentro de 2 horas"
+//	translator.FormatRelativeTime("ru", -5*time.Minute) // "5 минут назад"
+//	translator.FormatRelativeTime("en", time.Second)    // "now"
+func (l Translator) FormatRelativeTime(lang string, offset time.Duration) string {
+	idx := l.langIndex(lang)
+	rf := l.langSupported[idx].relative
+
+	abs := offset
+	if abs < 0 {
+		abs = -max(abs, minDuration)
+	}
+
+	threshold := l.nowThreshold
+	if threshold == 0 {
+		threshold = defaultNowThreshold
+	}
+	if abs < threshold {
+		return rf.Now
+	}
+
+	var unit TimeUnit
+	var n int64
+	const day = 24 * time.Hour
+	switch {
+	case abs < time.Minute:
+		unit, n = Second, int64(abs/time.Second)
+	case abs < time.Hour:
+		unit, n = Minute, int64(abs/time.Minute)
+	case abs < day:
+		unit, n = Hour, int64(abs/time.Hour)
+	case abs < 7*day:
+		unit, n = Day, int64(abs/day)
+	case abs < 30*day:
+		unit, n = Week, int64(abs/(7*day))
+	case abs < 365*day:
+		unit, n = Month, int64(abs/(30*day))
+	default:
+		unit, n = Year, int64(abs/(365*day))
+	}
+
+	forms := rf.Relative[unit]
+	if forms[PluralOther] == "" {
+		forms = rf.Units[unit]
+	}
+	amount := l.pluralText(idx, forms, n)
+
+	pattern := rf.Future
+	if offset < 0 {
+		pattern = rf.Past
+	}
+	return strings.ReplaceAll(pattern, "{0}", amount)
+}
+
+// FormatSince formats the time elapsed since t, or remaining until t, relative
+// to now. See FormatRelativeTime.
+//
+// Example usage:
+//
+//	translator.FormatSince("en", post.CreatedAt, time.Now()) // "2 hours ago"
+func (l Translator) FormatSince(lang string, t, now time.Time) string {
+	return l.FormatRelativeTime(lang, t.Sub(now))
+}
+
+// minDuration is the smallest duration whose negation does not overflow.
+// It differs from math.MinInt64 by one nanosecond, below any written unit.
+const minDuration = time.Duration(math.MinInt64 + 1)
+
+// FormatDuration formats an elapsed duration with days, hours, minutes and
+// seconds in the given language, omitting units equal to zero. Fractions of a
+// second are truncated. An empty or unsupported language uses the default language.
+//
+// Example usage:
+//
+//	translator.FormatDuration("en", 2*time.Hour+5*time.Minute) // "2 hours 5 minutes"
+//	translator.FormatDuration("ru", 3*time.Minute)             // "3 минуты"
+func (l Translator) FormatDuration(lang string, d time.Duration) string {
+	idx := l.langIndex(lang)
+	conv := l.langSupported[idx]
+
+	var sign string
+	if d < 0 {
+		sign, d = "-", -max(d, minDuration)
+	}
+
+	parts := []struct {
+		unit TimeUnit
+		size time.Duration
+	}{
+		{Day, 24 * time.Hour},
+		{Hour, time.Hour},
+		{Minute, time.Minute},
+		{Second, time.Second},
+	}
+
+	var out []string
+	for _, p := range parts {
+		n := int64(d / p.size)
+		d -= time.Duration(n) * p.size
+		if n > 0 {
+			out = append(out, l.pluralText(idx, conv.relative.Units[p.unit], n))
+		}
+	}
+	if len(out) == 0 {
+		out = append(out, l.pluralText(idx, conv.relative.Units[Second], 0))
+	}
+
+	return sign + strings.Join(out, conv.join.Separator)
+}
+
+// pluralText selects the plural form of n in the language at idx and
+// replaces the placeholder with n formatted as a number
+func (l *Translator) pluralText(idx int, forms PluralForms, n int64) string {
+	lang := l.langSupported[idx]
+	form := forms.form(Plural(lang.Code, float64(n)))
+	return strings.ReplaceAll(form, "{0}", lang.number.formatInt(n))
+}
@@ -0,0 +1,98 @@
+package tinytranslator
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFormatRelativeTime(t *testing.T) {
+	translator := NewTranslationEngine()
+	day := 24 * time.Hour
+
+	tests := []struct {
+		lang   string
+		offset time.Duration
+		want   string
+	}{
+		{"en", -3 * day, "3 days ago"},
+		{"en", 2 * time.Hour, "in 2 hours"},
+		{"en", -time.Minute, "1 minute ago"},
+		{"en", 5 * time.Second, "now"},
+		{"en", -30 * time.Second, "30 seconds ago"},
+		{"en", -400 * day, "1 year ago"},
+		{"en", 14 * day, "in 2 weeks"},
+		{"es", -3 * day, "hace 3 días"},
+		{"es", 2 * time.Hour, "dentro de 2 horas"},
+		{"fr", -time.Hour, "il y a 1 heure"},
+		{"de", -3 * day, "vor 3 Tagen"},
+		{"de", 60 * day, "in 2 Monaten"},
+		{"ru", -5 * time.Minute, "5 минут назад"},
+		{"ru", -2 * time.Minute, "2 минуты назад"},
+		{"ru", 21 * time.Minute, "через 21 минуту"},
+		{"ar", -2 * day, "قبل يومين"},
+		{"ar", -5 * day, "قبل 5 أيام"},
+		{"zh", -3 * day, "3天前"},
+		{"zh", time.Second, "现在"},
+		{"en", math.MinInt64, "292 years ago"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.FormatRelativeTime(tt.lang, tt.offset); got != tt.want {
+			t.Errorf("FormatRelativeTime(%q, %v) = %q; want %q", tt.lang, tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestNowThreshold(t *testing.T) {
+	translator := NewTranslationEngine().WithNowThreshold(time.Minute)
+
+	if got := translator.FormatRelativeTime("en", -45*time.Second); got != "now" {
+		t.Errorf("FormatRelativeTime() = %q; want %q", got, "now")
+	}
+
+	now := time.Date(2025, time.April, 3, 12, 0, 0, 0, time.UTC)
+	if got := translator.FormatSince("en", now.Add(-2*time.Hour), now); got != "2 hours ago" {
+		t.Errorf("FormatSince() = %q; want %q", got, "2 hours ago")
+	}
+
+	translator.WithNowThreshold(-1)
+	if got := translator.FormatRelativeTime("en", -time.Second); got != "1 second ago" {
+		t.Errorf("FormatRelativeTime() with threshold disabled = %q; want %q", got, "1 second ago")
+	}
+
+	translator.WithNowThreshold(0)
+	if got := translator.FormatRelativeTime("en", -5*time.Second); got != "now" {
+		t.Errorf("FormatRelativeTime() with default threshold = %q; want %q", got, "now")
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang string
+		d    time.Duration
+		want string
+	}{
+		{"en", 2*time.Hour + 5*time.Minute, "2 hours 5 minutes"},
+		{"en", 26*time.Hour + time.Second, "1 day 2 hours 1 second"},
+		{"en", 0, "0 seconds"},
+		{"en", -90 * time.Second, "-1 minute 30 seconds"},
+		{"es", 90 * time.Minute, "1 hora 30 minutos"},
+		{"de", 3 * 24 * time.Hour, "3 Tage"},
+		{"ru", 3 * time.Minute, "3 минуты"},
+		{"zh", 2*time.Hour + 5*time.Minute, "2小时5分钟"},
+		{"en", math.MinInt64, "-106,751 days 23 hours 47 minutes 16 seconds"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.FormatDuration(tt.lang, tt.d); got != tt.want {
+			t.Errorf("FormatDuration(%q, %v) = %q; want %q", tt.lang, tt.d, got, tt.want)
+		}
+	}
+
+	if got := translator.T("es", D.Hour, ':', 90*time.Minute); got != "hora: 1 hora 30 minutos" {
+		t.Errorf("T() with duration = %q", got)
+	}
+}
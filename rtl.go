@@ -0,0 +1,67 @@
+package tinytranslator
+
+import "slices"
+
+// Unicode directional formatting characters
+const (
+	rli = "\u2067" // RIGHT-TO-LEFT ISOLATE
+	fsi = "\u2068" // FIRST STRONG ISOLATE
+	pdi = "\u2069" // POP DIRECTIONAL ISOLATE
+)
+
+// rtlLanguages lists the language codes written from right to left
+var rtlLanguages = []string{"ar", "ur", "fa", "he", "ps", "sd", "yi", PseudoLocaleRTL}
+
+// IsRTL reports whether the language code is written from right to left.
+//
+// Example usage:
+//
+//	IsRTL("ar") // true
+//	IsRTL("es") // false
+func IsRTL(code string) bool {
+	return slices.Contains(rtlLanguages, code)
+}
+
+// WithBidiIsolation enables bidirectional isolation of inserted values.
+//
+// When the target language is right-to-left (eg: "ar", "ur"), every value that
+// is not a dictionary entry (user strings, numbers, booleans and errors) is
+// wrapped with FIRST STRONG ISOLATE (U+2068) and POP DIRECTIONAL ISOLATE (U+2069)
+// so that it keeps its own direction inside the translated message. Entries
+// without translation that fall back to a left-to-right language are isolated too.
+//
+// Example usage:
+//
+//	translator := NewTranslationEngine("ar").WithBidiIsolation()
+//	translator.T(D.Email, "john@mail.com", D.NotValid) // "البريد الإلكتروني \u2068john@mail.com\u2069 غير صالح"
+func (l *Translator) WithBidiIsolation() *Translator {
+	l.bidiIsolation = true
+	return l
+}
+
+// Isolate wraps a whole message with directional isolates so it can be embedded
+// in left-to-right contexts such as logs and terminals without reordering the
+// surrounding text. Right-to-left languages use RIGHT-TO-LEFT ISOLATE (U+2067),
+// any other language uses FIRST STRONG ISOLATE (U+2068).
+//
+// Example usage:
+//
+//	msg := translator.T("ar", D.Email, D.NotValid)
+//	log.Println("error:", Isolate("ar", msg))
+func Isolate(lang, message string) string {
+	if message == "" {
+		return ""
+	}
+	if IsRTL(lang) {
+		return rli + message + pdi
+	}
+	return fsi + message + pdi
+}
+
+// isolateIf wraps the value with FSI/PDI when isolate is true
+func isolateIf(isolate bool, value string) string {
+	if !isolate || value == "" {
+		return value
+	}
+	return fsi + value + pdi
+}
@@ -0,0 +1,72 @@
+package tinytranslator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBidiIsolation(t *testing.T) {
+	translator := NewTranslationEngine().WithBidiIsolation()
+
+	tests := []struct {
+		name string
+		args []any
+		want string
+	}{
+		{
+			name: "rtl user value",
+			args: []any{"ar", D.Email, "john@mail.com", D.NotValid},
+			want: "البريد الإلكتروني " + fsi + "john@mail.com" + pdi + " غير صالح",
+		},
+		{
+			name: "rtl number and error",
+			args: []any{"ur", 42, errors.New("EOF")},
+			want: fsi + "42" + pdi + " " + fsi + "EOF" + pdi,
+		},
+		{
+			name: "ltr language is not isolated",
+			args: []any{"es", D.Email, "john@mail.com", D.NotValid},
+			want: "correo electrónico john@mail.com no es valido",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := translator.T(tt.args...); got != tt.want {
+				t.Errorf("T() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+
+	var form struct {
+		Nickname string `es:"apodo"`
+	}
+	if err := translator.LoadNamespace("form", &form); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+	want := fsi + "nickname" + pdi + " غير صالح"
+	if got := translator.T("ar", form.Nickname, D.NotValid); got != want {
+		t.Errorf("T() with english fallback = %q; want %q", got, want)
+	}
+	if got := translator.T("es", form.Nickname, D.NotValid); got != "apodo no es valido" {
+		t.Errorf("T() in es = %q; want %q", got, "apodo no es valido")
+	}
+
+	// Without the option values are never isolated
+	plain := NewTranslationEngine()
+	if got := plain.T("ar", 42); got != "42" {
+		t.Errorf("T() without isolation = %q; want %q", got, "42")
+	}
+}
+
+func TestIsolate(t *testing.T) {
+	if got := Isolate("ar", "مرحبا"); got != rli+"مرحبا"+pdi {
+		t.Errorf("Isolate(ar) = %q", got)
+	}
+	if got := Isolate("en", "hello"); got != fsi+"hello"+pdi {
+		t.Errorf("Isolate(en) = %q", got)
+	}
+	if got := Isolate("ar", ""); got != "" {
+		t.Errorf("Isolate(empty) = %q; want empty", got)
+	}
+}
@@ -1,37 +1,74 @@
 package tinytranslator
 
+import (
+	"strings"
+	"unicode"
+)
+
+// KeyDerivation builds the lookup key of a namespace entry from its field name,
+// eg: SnakeKey, KebabKey or DottedKey
+type KeyDerivation func(fieldName string) string
+
+// SnakeKey derives keys in snake case, eg: "APIResponse" -> "api_response". It is the default.
+func SnakeKey(fieldName string) string { return snakeCase(fieldName) }
+
+// KebabKey derives keys in kebab case, eg: "APIResponse" -> "api-response"
+func KebabKey(fieldName string) string { return snakeCase(fieldName, "-") }
+
+// DottedKey derives keys separated by dots, eg: "APIResponse" -> "api.response"
+func DottedKey(fieldName string) string { return snakeCase(fieldName, ".") }
+
 // snakeCase converts a string to snake_case format with optional separator.
 // If no separator is provided, underscore "_" is used as default.
+// Letters of any script are supported, a new word starts at an uppercase
+// letter following a lowercase letter or a digit, and at the last uppercase
+// letter of an acronym followed by a lowercase one.
 // Example:
 //
 //	Input: "camelCase" -> Output: "camel_case"
 //	Input: "PascalCase", "-" -> Output: "pascal-case"
 //	Input: "APIResponse" -> Output: "api_response"
 //	Input: "user123Name", "." -> Output: "user123.name"
+//	Input: "GrößeMax" -> Output: "größe_max"
 func snakeCase(str string, sep ...string) string {
 	separator := "_"
 	if len(sep) > 0 {
 		separator = sep[0]
 	}
 
-	var out []byte
-	for i, r := range str {
-		if r >= 'A' && r <= 'Z' { // IsUpper - solo ASCII
-			// Si es mayúscula y no es el primer carácter
-			if i > 0 {
-				prev := rune(str[i-1])
-				// Si el carácter anterior es minúscula o dígito
-				if (prev >= 'a' && prev <= 'z') || (prev >= '0' && prev <= '9') {
-					out = append(out, separator...)
-				}
+	runes := []rune(str)
+	var out strings.Builder
+	out.Grow(len(str) + 4)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			// Si el carácter anterior es minúscula o dígito
+			wordStart := unicode.IsLower(prev) || unicode.IsDigit(prev)
+			// Última mayúscula de un acrónimo seguida de minúscula, eg: "APIResponse"
+			if unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+				wordStart = true
+			}
+			if wordStart {
+				out.WriteString(separator)
 			}
-			// Convertir a minúscula (diferencia ASCII entre 'A' y 'a' es 32)
-			out = append(out, byte(r+32))
-		} else {
-			// Si no es mayúscula, simplemente añadirla
-			out = append(out, byte(r))
 		}
+		out.WriteRune(unicode.ToLower(r))
 	}
 
-	return string(out)
+	return out.String()
+}
+
+// trimContext removes a context from the end of a key with the separator
+// before it, eg: "space_char" and "char" is "space"
+func trimContext(key, context string) string {
+	trimmed, found := strings.CutSuffix(key, context)
+	if !found || trimmed == "" {
+		return key
+	}
+	last := []rune(trimmed)[len([]rune(trimmed))-1]
+	if unicode.IsLetter(last) || unicode.IsDigit(last) {
+		return key
+	}
+	return strings.TrimSuffix(trimmed, string(last))
 }
@@ -28,3 +28,52 @@ func TestSnakeCase(t *testing.T) {
 		})
 	}
 }
+
+func TestSnakeCaseUnicode(t *testing.T) {
+	testCases := []struct {
+		input string
+		sep   string
+		want  string
+	}{
+		{"APIResponse", "_", "api_response"},
+		{"HTTPServerError", "_", "http_server_error"},
+		{"userID", "_", "user_id"},
+		{"user123Name", ".", "user123.name"},
+		{"Año", "_", "año"},
+		{"GrößeMax", "_", "größe_max"},
+		{"ÉtatCivil", " ", "état civil"},
+		{"ПолеВвода", "_", "поле_ввода"},
+		{"ZipCode", "-", "zip-code"},
+	}
+
+	for _, tc := range testCases {
+		if got := snakeCase(tc.input, tc.sep); got != tc.want {
+			t.Errorf("snakeCase(%q, %q) = %q; want %q", tc.input, tc.sep, got, tc.want)
+		}
+	}
+}
+
+func TestKeyDerivations(t *testing.T) {
+	testCases := []struct {
+		name   string
+		derive KeyDerivation
+		want   string
+	}{
+		{"snake", SnakeKey, "api_response"},
+		{"kebab", KebabKey, "api-response"},
+		{"dotted", DottedKey, "api.response"},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.derive("APIResponse"); got != tc.want {
+			t.Errorf("%s(APIResponse) = %q; want %q", tc.name, got, tc.want)
+		}
+	}
+
+	if got := trimContext("space-char", KebabKey("Char")); got != "space" {
+		t.Errorf("trimContext(space-char, char) = %q; want %q", got, "space")
+	}
+	if got := trimContext("spacechar", "char"); got != "spacechar" {
+		t.Errorf("trimContext(spacechar, char) = %q; want it unchanged", got)
+	}
+}
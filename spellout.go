@@ -0,0 +1,852 @@
+package tinytranslator
+
+import "strings"
+
+// Spelled wraps a number so T() writes it in words, eg: "one hundred twenty".
+//
+// Example usage:
+//
+//	translator.T("es", Spelled{N: 120}) // "ciento veinte"
+type Spelled struct {
+	N      int64
+	Gender Gender
+}
+
+// spellers writes a non negative number in words for each language
+var spellers = map[string]func(n uint64, g Gender) string{
+	"en": spellEN,
+	"es": spellES,
+	"pt": spellPT,
+	"fr": spellFR,
+	"ru": spellRU,
+	"de": spellDE,
+	"it": spellIT,
+	"hi": spellHI,
+	"bn": spellBN,
+	"id": spellID,
+	"ar": spellAR,
+	"ur": spellUR,
+	"zh": spellZH,
+}
+
+// minusWords is the word written before negative numbers
+var minusWords = map[string]string{
+	"en": "minus ", "es": "menos ", "pt": "menos ", "fr": "moins ", "ru": "минус ",
+	"de": "minus ", "it": "meno ", "hi": "ऋण ", "bn": "ঋণাত্মক ", "id": "minus ",
+	"ar": "سالب ", "ur": "منفی ", "zh": "负",
+}
+
+// SpellOut writes n in words in the given language, eg: for printed receipts.
+// The gender selects the agreeing forms in languages that have them (eg:
+// "doscientas una" in es). Languages without spell-out rules write the number
+// with digits. An empty or unsupported language uses the default language.
+//
+// Example usage:
+//
+//	translator.SpellOut("en", 120, Neutral) // "one hundred twenty"
+//	translator.SpellOut("es", 120, Neutral) // "ciento veinte"
+//	translator.SpellOut("fr", 80, Neutral)  // "quatre-vingts"
+//	translator.SpellOut("zh", 105, Neutral) // "一百零五"
+func (l Translator) SpellOut(lang string, n int64, g Gender) string {
+	return l.spellOut(l.langIndex(lang), n, g)
+}
+
+// spellOut writes n in words in the language at idx
+func (l *Translator) spellOut(idx int, n int64, g Gender) string {
+	conv := l.langSupported[idx]
+	spell, ok := spellers[conv.Code]
+	if !ok {
+		return conv.number.formatInt(n)
+	}
+	if n < 0 {
+		return minusWords[conv.Code] + spell(uint64(-(n+1))+1, g)
+	}
+	return spell(uint64(n), g)
+}
+
+// trimJoin joins the non empty parts with sep
+func trimJoin(sep string, parts ...string) string {
+	var out []string
+	for _, p := range parts {
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return strings.Join(out, sep)
+}
+
+// english
+
+var (
+	enOnes = [20]string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	enTens   = [10]string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	enScales = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+func spellEN(n uint64, _ Gender) string {
+	if n == 0 {
+		return enOnes[0]
+	}
+	below1000 := func(n uint64) string {
+		var tens string
+		switch r := n % 100; {
+		case r == 0:
+		case r < 20:
+			tens = enOnes[r]
+		default:
+			tens = enTens[r/10]
+			if r%10 > 0 {
+				tens += "-" + enOnes[r%10]
+			}
+		}
+		var hundreds string
+		if n >= 100 {
+			hundreds = enOnes[n/100] + " hundred"
+		}
+		return trimJoin(" ", hundreds, tens)
+	}
+
+	var parts []string
+	for i, group := range groupsOf1000(n) {
+		if group > 0 {
+			parts = append([]string{trimJoin(" ", below1000(group), enScales[i])}, parts...)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// groupsOf1000 splits n in groups of three digits, starting from the units
+func groupsOf1000(n uint64) []uint64 {
+	var groups []uint64
+	for n > 0 {
+		groups = append(groups, n%1000)
+		n /= 1000
+	}
+	return groups
+}
+
+// spanish
+
+var (
+	esOnes = [30]string{"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve",
+		"diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve",
+		"veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve"}
+	esTens     = [10]string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+	esHundreds = [10]string{"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos",
+		"seiscientos", "setecientos", "ochocientos", "novecientos"}
+)
+
+// spellESBelow1000 writes n < 1000 in spanish. When apocope is true "uno"
+// becomes "un" as before a noun, eg: "veintiún mil".
+func spellESBelow1000(n uint64, g Gender, apocope bool) string {
+	if n == 100 {
+		return "cien"
+	}
+
+	hundreds := esHundreds[n/100]
+	if g == Feminine && n >= 200 {
+		hundreds = strings.TrimSuffix(hundreds, "os") + "as"
+	}
+
+	var rest string
+	switch r := n % 100; {
+	case r == 0:
+	case r < 30:
+		rest = esOnes[r]
+	default:
+		rest = esTens[r/10]
+		if r%10 > 0 {
+			rest += " y " + esOnes[r%10]
+		}
+	}
+
+	if strings.HasSuffix(rest, "uno") {
+		switch {
+		case g == Feminine:
+			rest = strings.TrimSuffix(rest, "uno") + "una"
+		case apocope && rest == "veintiuno":
+			rest = "veintiún"
+		case apocope:
+			rest = strings.TrimSuffix(rest, "uno") + "un"
+		}
+	}
+	return trimJoin(" ", hundreds, rest)
+}
+
+// spellESBelowMillion writes n < 1000000 in spanish
+func spellESBelowMillion(n uint64, g Gender, apocope bool) string {
+	thousands, rest := n/1000, n%1000
+	var head string
+	switch {
+	case thousands == 1:
+		head = "mil"
+	case thousands > 1:
+		head = spellESBelow1000(thousands, g, true) + " mil"
+	}
+	var tail string
+	if rest > 0 || thousands == 0 {
+		tail = spellESBelow1000(rest, g, apocope)
+	}
+	return trimJoin(" ", head, tail)
+}
+
+func spellES(n uint64, g Gender) string {
+	if n == 0 {
+		return esOnes[0]
+	}
+	// long scale: millón (10^6), billón (10^12), trillón (10^18)
+	scales := []struct {
+		size           uint64
+		single, plural string
+	}{
+		{1e18, "trillón", "trillones"},
+		{1e12, "billón", "billones"},
+		{1e6, "millón", "millones"},
+	}
+
+	var parts []string
+	for _, s := range scales {
+		if count := n / s.size; count > 0 {
+			if count == 1 {
+				parts = append(parts, "un "+s.single)
+			} else {
+				parts = append(parts, spellESBelowMillion(count, Masculine, true)+" "+s.plural)
+			}
+			n %= s.size
+		}
+	}
+	if n > 0 {
+		parts = append(parts, spellESBelowMillion(n, g, false))
+	}
+	return strings.Join(parts, " ")
+}
+
+// portuguese
+
+var (
+	ptOnes = [20]string{"zero", "um", "dois", "três", "quatro", "cinco", "seis", "sete", "oito", "nove",
+		"dez", "onze", "doze", "treze", "catorze", "quinze", "dezesseis", "dezessete", "dezoito", "dezenove"}
+	ptTens     = [10]string{"", "", "vinte", "trinta", "quarenta", "cinquenta", "sessenta", "setenta", "oitenta", "noventa"}
+	ptHundreds = [10]string{"", "cento", "duzentos", "trezentos", "quatrocentos", "quinhentos",
+		"seiscentos", "setecentos", "oitocentos", "novecentos"}
+	ptScales = [][2]string{{"", ""}, {"mil", "mil"}, {"milhão", "milhões"}, {"bilhão", "bilhões"},
+		{"trilhão", "trilhões"}, {"quatrilhão", "quatrilhões"}, {"quintilhão", "quintilhões"}}
+)
+
+// spellPTBelow1000 writes 0 < n < 1000 in portuguese
+func spellPTBelow1000(n uint64, g Gender) string {
+	if n == 100 {
+		return "cem"
+	}
+	hundreds := ptHundreds[n/100]
+	if g == Feminine && n >= 200 {
+		hundreds = strings.TrimSuffix(hundreds, "os") + "as"
+	}
+
+	feminine := func(r uint64) string {
+		switch {
+		case g == Feminine && r == 1:
+			return "uma"
+		case g == Feminine && r == 2:
+			return "duas"
+		}
+		return ptOnes[r]
+	}
+
+	var rest string
+	switch r := n % 100; {
+	case r == 0:
+	case r < 20:
+		rest = feminine(r)
+	default:
+		rest = ptTens[r/10]
+		if r%10 > 0 {
+			rest += " e " + feminine(r%10)
+		}
+	}
+	return trimJoin(" e ", hundreds, rest)
+}
+
+func spellPT(n uint64, g Gender) string {
+	if n == 0 {
+		return ptOnes[0]
+	}
+
+	groups := groupsOf1000(n)
+	var parts []string
+	var last uint64 // value of the lowest non zero group
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		if group == 0 {
+			continue
+		}
+		var text string
+		switch {
+		case i == 0:
+			text = spellPTBelow1000(group, g)
+		case i == 1 && group == 1:
+			text = "mil"
+		case i == 1:
+			text = spellPTBelow1000(group, g) + " mil"
+		case group == 1:
+			text = "um " + ptScales[i][0]
+		default:
+			text = spellPTBelow1000(group, Masculine) + " " + ptScales[i][1]
+		}
+		parts = append(parts, text)
+		last = group
+	}
+
+	// "e" joins the last group when it is below one hundred or a round hundred
+	if len(parts) > 1 && (last < 100 || last%100 == 0) {
+		return strings.Join(parts[:len(parts)-1], " ") + " e " + parts[len(parts)-1]
+	}
+	return strings.Join(parts, " ")
+}
+
+// french
+
+var frOnes = [20]string{"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf",
+	"dix", "onze", "douze", "treize", "quatorze", "quinze", "seize", "dix-sept", "dix-huit", "dix-neuf"}
+
+// spellFRBelow100 writes n < 100 in french
+func spellFRBelow100(n uint64) string {
+	tens := [10]string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante", "soixante", "quatre-vingt", "quatre-vingt"}
+	switch {
+	case n < 20:
+		return frOnes[n]
+	case n == 80:
+		return "quatre-vingts"
+	}
+
+	t, u := n/10, n%10
+	if t == 7 || t == 9 {
+		// 70-79 and 90-99 count from ten: soixante-dix, quatre-vingt-onze
+		u += 10
+	}
+	switch {
+	case u == 0:
+		return tens[t]
+	case u == 1 && t < 8, u == 11 && t == 7:
+		return tens[t] + " et " + frOnes[u]
+	}
+	return tens[t] + "-" + frOnes[u]
+}
+
+// spellFRBelow1000 writes 0 < n < 1000 in french. When final is false the
+// plural "s" of "cents" and "quatre-vingts" is dropped, as before "mille".
+func spellFRBelow1000(n uint64, final bool) string {
+	h, r := n/100, n%100
+	var hundreds string
+	switch {
+	case h == 1:
+		hundreds = "cent"
+	case h > 1:
+		hundreds = frOnes[h] + " cent"
+		if r == 0 && final {
+			hundreds += "s"
+		}
+	}
+	var rest string
+	if r > 0 {
+		rest = spellFRBelow100(r)
+		if !final && r == 80 {
+			rest = "quatre-vingt"
+		}
+	}
+	return trimJoin(" ", hundreds, rest)
+}
+
+func spellFR(n uint64, g Gender) string {
+	if n == 0 {
+		return frOnes[0]
+	}
+	scales := [][2]string{{"", ""}, {"mille", "mille"}, {"million", "millions"}, {"milliard", "milliards"},
+		{"billion", "billions"}, {"billiard", "billiards"}, {"trillion", "trillions"}}
+
+	groups := groupsOf1000(n)
+	var parts []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		switch {
+		case group == 0:
+		case i == 0:
+			text := spellFRBelow1000(group, true)
+			if g == Feminine && group%10 == 1 && group%100 != 11 {
+				text = strings.TrimSuffix(text, "un") + "une"
+			}
+			parts = append(parts, text)
+		case i == 1 && group == 1:
+			parts = append(parts, "mille")
+		case i == 1:
+			parts = append(parts, spellFRBelow1000(group, false)+" mille")
+		case group == 1:
+			parts = append(parts, "un "+scales[i][0])
+		default:
+			parts = append(parts, spellFRBelow1000(group, true)+" "+scales[i][1])
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// russian
+
+var (
+	ruOnes = [20]string{"ноль", "один", "два", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять",
+		"десять", "одиннадцать", "двенадцать", "тринадцать", "четырнадцать", "пятнадцать", "шестнадцать", "семнадцать", "восемнадцать", "девятнадцать"}
+	ruTens     = [10]string{"", "", "двадцать", "тридцать", "сорок", "пятьдесят", "шестьдесят", "семьдесят", "восемьдесят", "девяносто"}
+	ruHundreds = [10]string{"", "сто", "двести", "триста", "четыреста", "пятьсот", "шестьсот", "семьсот", "восемьсот", "девятьсот"}
+	// scale nouns in the forms one, few and many
+	ruScales = [][3]string{{}, {"тысяча", "тысячи", "тысяч"}, {"миллион", "миллиона", "миллионов"},
+		{"миллиард", "миллиарда", "миллиардов"}, {"триллион", "триллиона", "триллионов"},
+		{"квадриллион", "квадриллиона", "квадриллионов"}, {"квинтиллион", "квинтиллиона", "квинтиллионов"}}
+)
+
+// spellRUBelow1000 writes 0 < n < 1000 in russian
+func spellRUBelow1000(n uint64, g Gender) string {
+	r := n % 100
+	var tens, units string
+	switch {
+	case r == 0:
+	case r < 20:
+		units = ruOnes[r]
+	default:
+		tens, units = ruTens[r/10], ruOnes[r%10]
+		if r%10 == 0 {
+			units = ""
+		}
+	}
+	if g == Feminine && (r < 10 || r > 20) {
+		switch r % 10 {
+		case 1:
+			units = "одна"
+		case 2:
+			units = "две"
+		}
+	}
+	return trimJoin(" ", ruHundreds[n/100], tens, units)
+}
+
+func spellRU(n uint64, g Gender) string {
+	if n == 0 {
+		return ruOnes[0]
+	}
+	groups := groupsOf1000(n)
+	var parts []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		switch {
+		case group == 0:
+		case i == 0:
+			parts = append(parts, spellRUBelow1000(group, g))
+		default:
+			// thousands are feminine, larger scales masculine
+			gender := Masculine
+			if i == 1 {
+				gender = Feminine
+			}
+			form := ruScales[i][2]
+			switch Plural("ru", float64(group)) {
+			case PluralOne:
+				form = ruScales[i][0]
+			case PluralFew:
+				form = ruScales[i][1]
+			}
+			parts = append(parts, spellRUBelow1000(group, gender)+" "+form)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// german
+
+var (
+	deOnes = [20]string{"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun",
+		"zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn"}
+	deTens = [10]string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+)
+
+// spellDEBelow1000 writes 0 < n < 1000 in german. When final is false "eins"
+// becomes "ein", as before "tausend".
+func spellDEBelow1000(n uint64, final bool) string {
+	var out string
+	if h := n / 100; h > 0 {
+		out = deOnes[h] + "hundert"
+		if h == 1 {
+			out = "einhundert"
+		}
+	}
+	switch r := n % 100; {
+	case r == 0:
+	case r == 1 && !final:
+		out += "ein"
+	case r < 20:
+		out += deOnes[r]
+	case r%10 == 0:
+		out += deTens[r/10]
+	case r%10 == 1:
+		out += "einund" + deTens[r/10]
+	default:
+		out += deOnes[r%10] + "und" + deTens[r/10]
+	}
+	return out
+}
+
+func spellDE(n uint64, _ Gender) string {
+	if n == 0 {
+		return deOnes[0]
+	}
+	scales := [][2]string{{}, {}, {"Million", "Millionen"}, {"Milliarde", "Milliarden"},
+		{"Billion", "Billionen"}, {"Billiarde", "Billiarden"}, {"Trillion", "Trillionen"}}
+
+	groups := groupsOf1000(n)
+	var parts []string
+	var belowMillion string
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		switch {
+		case group == 0:
+		case i == 0:
+			belowMillion += spellDEBelow1000(group, true)
+		case i == 1:
+			belowMillion += spellDEBelow1000(group, false) + "tausend"
+		case group == 1:
+			parts = append(parts, "eine "+scales[i][0])
+		default:
+			parts = append(parts, spellDEBelow1000(group, true)+" "+scales[i][1])
+		}
+	}
+	return trimJoin(" ", append(parts, belowMillion)...)
+}
+
+// italian
+
+var (
+	itOnes = [20]string{"zero", "uno", "due", "tre", "quattro", "cinque", "sei", "sette", "otto", "nove",
+		"dieci", "undici", "dodici", "tredici", "quattordici", "quindici", "sedici", "diciassette", "diciotto", "diciannove"}
+	itTens = [10]string{"", "", "venti", "trenta", "quaranta", "cinquanta", "sessanta", "settanta", "ottanta", "novanta"}
+)
+
+// spellITBelow1000 writes 0 < n < 1000 in italian. compound is true when n
+// is written at the end of a longer word, eg: the 3 of milletré.
+func spellITBelow1000(n uint64, compound bool) string {
+	var out string
+	if h := n / 100; h > 0 {
+		out = "cento"
+		if h > 1 {
+			out = itOnes[h] + "cento"
+		}
+	}
+	switch r := n % 100; {
+	case r == 0:
+	case r == 3 && (out != "" || compound):
+		// tre takes the accent at the end of a compound: centotré
+		out += "tré"
+	case r < 20:
+		out += itOnes[r]
+	case r/10 == 8 && out != "":
+		// cento drops its final vowel before ottanta: centottanta
+		out = out[:len(out)-1] + spellITBelow1000(r, true)
+	default:
+		tens := itTens[r/10]
+		switch u := r % 10; u {
+		case 0:
+			out += tens
+		case 1, 8:
+			// the final vowel is dropped before uno and otto: ventuno, ventotto
+			out += tens[:len(tens)-1] + itOnes[u]
+		case 3:
+			out += tens + "tré"
+		default:
+			out += tens + itOnes[u]
+		}
+	}
+	return out
+}
+
+func spellIT(n uint64, g Gender) string {
+	switch {
+	case n == 0:
+		return itOnes[0]
+	case n == 1 && g == Feminine:
+		return "una"
+	}
+	scales := [][2]string{{}, {}, {"milione", "milioni"}, {"miliardo", "miliardi"},
+		{"bilione", "bilioni"}, {"biliardo", "biliardi"}, {"trilione", "trilioni"}}
+
+	groups := groupsOf1000(n)
+	var parts []string
+	var belowMillion string
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		switch {
+		case group == 0:
+		case i == 0:
+			belowMillion += spellITBelow1000(group, belowMillion != "")
+		case i == 1 && group == 1:
+			belowMillion += "mille"
+		case i == 1:
+			belowMillion += spellITBelow1000(group, false) + "mila"
+		case group == 1:
+			parts = append(parts, "un "+scales[i][0])
+		default:
+			parts = append(parts, spellITBelow1000(group, false)+" "+scales[i][1])
+		}
+	}
+	return trimJoin(" ", append(parts, belowMillion)...)
+}
+
+// indonesian
+
+var idOnes = [10]string{"nol", "satu", "dua", "tiga", "empat", "lima", "enam", "tujuh", "delapan", "sembilan"}
+
+// spellIDBelow1000 writes 0 < n < 1000 in indonesian
+func spellIDBelow1000(n uint64) string {
+	var hundreds string
+	switch h := n / 100; {
+	case h == 1:
+		hundreds = "seratus"
+	case h > 1:
+		hundreds = idOnes[h] + " ratus"
+	}
+	var rest string
+	switch r := n % 100; {
+	case r == 0:
+	case r < 10:
+		rest = idOnes[r]
+	case r == 10:
+		rest = "sepuluh"
+	case r == 11:
+		rest = "sebelas"
+	case r < 20:
+		rest = idOnes[r%10] + " belas"
+	default:
+		rest = idOnes[r/10] + " puluh"
+		if r%10 > 0 {
+			rest += " " + idOnes[r%10]
+		}
+	}
+	return trimJoin(" ", hundreds, rest)
+}
+
+func spellID(n uint64, _ Gender) string {
+	if n == 0 {
+		return idOnes[0]
+	}
+	scales := []string{"", "ribu", "juta", "miliar", "triliun", "kuadriliun", "kuintiliun"}
+
+	groups := groupsOf1000(n)
+	var parts []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		switch {
+		case group == 0:
+		case i == 1 && group == 1:
+			parts = append(parts, "seribu")
+		default:
+			parts = append(parts, trimJoin(" ", spellIDBelow1000(group), scales[i]))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// chinese
+
+func spellZH(n uint64, _ Gender) string {
+	digits := []string{"零", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+	if n == 0 {
+		return digits[0]
+	}
+
+	// below10000 writes 0 < n < 10000, with a leading 零 when zeroPad is
+	// true and the section does not start with thousands
+	below10000 := func(n uint64, zeroPad bool) string {
+		units := []string{"千", "百", "十", ""}
+		var b strings.Builder
+		zero := zeroPad
+		for i, size := range []uint64{1000, 100, 10, 1} {
+			d := n / size % 10
+			if d == 0 {
+				if b.Len() > 0 || zeroPad {
+					zero = true
+				}
+				continue
+			}
+			if zero && (b.Len() > 0 || zeroPad) {
+				b.WriteString(digits[0])
+			}
+			zero = false
+			b.WriteString(digits[d] + units[i])
+		}
+		return b.String()
+	}
+
+	scales := []string{"", "万", "亿", "万亿", "亿亿"}
+	var sections []uint64
+	for m := n; m > 0; m /= 10000 {
+		sections = append(sections, m%10000)
+	}
+
+	var b strings.Builder
+	for i := len(sections) - 1; i >= 0; i-- {
+		section := sections[i]
+		if section == 0 {
+			continue
+		}
+		zeroPad := b.Len() > 0 && (section < 1000 || sections[i+1] == 0)
+		b.WriteString(below10000(section, zeroPad) + scales[i])
+	}
+
+	// a leading 一十 is written 十, eg: 十五, 十万
+	out := b.String()
+	if strings.HasPrefix(out, "一十") {
+		out = strings.TrimPrefix(out, "一")
+	}
+	return out
+}
+
+// hindi, urdu and bengali use the indian system: hundred, thousand, lakh (10^5) and crore (10^7)
+
+// indianSystem holds the words of a language that counts in lakhs and crores
+type indianSystem struct {
+	below100                       [100]string
+	hundred, thousand, lakh, crore string
+}
+
+// spell writes n in words with the indian grouping
+func (s *indianSystem) spell(n uint64) string {
+	if n == 0 {
+		return s.below100[0]
+	}
+	var parts []string
+	if crores := n / 1e7; crores > 0 {
+		parts = append(parts, s.spell(crores)+" "+s.crore)
+		n %= 1e7
+	}
+	for _, unit := range []struct {
+		size uint64
+		word string
+	}{{1e5, s.lakh}, {1000, s.thousand}, {100, s.hundred}} {
+		if count := n / unit.size; count > 0 {
+			parts = append(parts, s.below100[count]+" "+unit.word)
+			n %= unit.size
+		}
+	}
+	if n > 0 {
+		parts = append(parts, s.below100[n])
+	}
+	return strings.Join(parts, " ")
+}
+
+var hiSystem = indianSystem{
+	below100: [100]string{"शून्य", "एक", "दो", "तीन", "चार", "पाँच", "छह", "सात", "आठ", "नौ",
+		"दस", "ग्यारह", "बारह", "तेरह", "चौदह", "पंद्रह", "सोलह", "सत्रह", "अठारह", "उन्नीस",
+		"बीस", "इक्कीस", "बाईस", "तेईस", "चौबीस", "पच्चीस", "छब्बीस", "सत्ताईस", "अट्ठाईस", "उनतीस",
+		"तीस", "इकतीस", "बत्तीस", "तैंतीस", "चौंतीस", "पैंतीस", "छत्तीस", "सैंतीस", "अड़तीस", "उनतालीस",
+		"चालीस", "इकतालीस", "बयालीस", "तैंतालीस", "चवालीस", "पैंतालीस", "छियालीस", "सैंतालीस", "अड़तालीस", "उनचास",
+		"पचास", "इक्यावन", "बावन", "तिरपन", "चौवन", "पचपन", "छप्पन", "सत्तावन", "अट्ठावन", "उनसठ",
+		"साठ", "इकसठ", "बासठ", "तिरसठ", "चौंसठ", "पैंसठ", "छियासठ", "सड़सठ", "अड़सठ", "उनहत्तर",
+		"सत्तर", "इकहत्तर", "बहत्तर", "तिहत्तर", "चौहत्तर", "पचहत्तर", "छिहत्तर", "सतहत्तर", "अठहत्तर", "उन्यासी",
+		"अस्सी", "इक्यासी", "बयासी", "तिरासी", "चौरासी", "पचासी", "छियासी", "सत्तासी", "अट्ठासी", "नवासी",
+		"नब्बे", "इक्यानबे", "बानबे", "तिरानबे", "चौरानबे", "पचानबे", "छियानबे", "सत्तानबे", "अट्ठानबे", "निन्यानबे"},
+	hundred: "सौ", thousand: "हज़ार", lakh: "लाख", crore: "करोड़",
+}
+
+var urSystem = indianSystem{
+	below100: [100]string{"صفر", "ایک", "دو", "تین", "چار", "پانچ", "چھ", "سات", "آٹھ", "نو",
+		"دس", "گیارہ", "بارہ", "تیرہ", "چودہ", "پندرہ", "سولہ", "سترہ", "اٹھارہ", "انیس",
+		"بیس", "اکیس", "بائیس", "تئیس", "چوبیس", "پچیس", "چھبیس", "ستائیس", "اٹھائیس", "انتیس",
+		"تیس", "اکتیس", "بتیس", "تینتیس", "چونتیس", "پینتیس", "چھتیس", "سینتیس", "اڑتیس", "انتالیس",
+		"چالیس", "اکتالیس", "بیالیس", "تینتالیس", "چوالیس", "پینتالیس", "چھیالیس", "سینتالیس", "اڑتالیس", "انچاس",
+		"پچاس", "اکیاون", "باون", "ترپن", "چون", "پچپن", "چھپن", "ستاون", "اٹھاون", "انسٹھ",
+		"ساٹھ", "اکسٹھ", "باسٹھ", "ترسٹھ", "چونسٹھ", "پینسٹھ", "چھیاسٹھ", "سڑسٹھ", "اڑسٹھ", "انہتر",
+		"ستر", "اکہتر", "بہتر", "تہتر", "چوہتر", "پچہتر", "چھہتر", "ستتر", "اٹھتر", "اناسی",
+		"اسی", "اکیاسی", "بیاسی", "تراسی", "چوراسی", "پچاسی", "چھیاسی", "ستاسی", "اٹھاسی", "نواسی",
+		"نوے", "اکیانوے", "بانوے", "ترانوے", "چورانوے", "پچانوے", "چھیانوے", "ستانوے", "اٹھانوے", "ننانوے"},
+	hundred: "سو", thousand: "ہزار", lakh: "لاکھ", crore: "کروڑ",
+}
+
+var bnSystem = indianSystem{
+	below100: [100]string{"শূন্য", "এক", "দুই", "তিন", "চার", "পাঁচ", "ছয়", "সাত", "আট", "নয়",
+		"দশ", "এগারো", "বারো", "তেরো", "চৌদ্দ", "পনেরো", "ষোলো", "সতেরো", "আঠারো", "উনিশ",
+		"বিশ", "একুশ", "বাইশ", "তেইশ", "চব্বিশ", "পঁচিশ", "ছাব্বিশ", "সাতাশ", "আটাশ", "ঊনত্রিশ",
+		"ত্রিশ", "একত্রিশ", "বত্রিশ", "তেত্রিশ", "চৌত্রিশ", "পঁয়ত্রিশ", "ছত্রিশ", "সাঁইত্রিশ", "আটত্রিশ", "ঊনচল্লিশ",
+		"চল্লিশ", "একচল্লিশ", "বিয়াল্লিশ", "তেতাল্লিশ", "চুয়াল্লিশ", "পঁয়তাল্লিশ", "ছেচল্লিশ", "সাতচল্লিশ", "আটচল্লিশ", "ঊনপঞ্চাশ",
+		"পঞ্চাশ", "একান্ন", "বাহান্ন", "তিপ্পান্ন", "চুয়ান্ন", "পঞ্চান্ন", "ছাপ্পান্ন", "সাতান্ন", "আটান্ন", "ঊনষাট",
+		"ষাট", "একষট্টি", "বাষট্টি", "তেষট্টি", "চৌষট্টি", "পঁয়ষট্টি", "ছেষট্টি", "সাতষট্টি", "আটষট্টি", "ঊনসত্তর",
+		"সত্তর", "একাত্তর", "বাহাত্তর", "তিয়াত্তর", "চুয়াত্তর", "পঁচাত্তর", "ছিয়াত্তর", "সাতাত্তর", "আটাত্তর", "ঊনআশি",
+		"আশি", "একাশি", "বিরাশি", "তিরাশি", "চুরাশি", "পঁচাশি", "ছিয়াশি", "সাতাশি", "অষ্টাশি", "ঊননব্বই",
+		"নব্বই", "একানব্বই", "বিরানব্বই", "তিরানব্বই", "চুরানব্বই", "পঁচানব্বই", "ছিয়ানব্বই", "সাতানব্বই", "আটানব্বই", "নিরানব্বই"},
+	hundred: "শত", thousand: "হাজার", lakh: "লক্ষ", crore: "কোটি",
+}
+
+func spellHI(n uint64, _ Gender) string { return hiSystem.spell(n) }
+func spellUR(n uint64, _ Gender) string { return urSystem.spell(n) }
+func spellBN(n uint64, _ Gender) string { return bnSystem.spell(n) }
+
+// arabic
+
+var (
+	arOnes = [20]string{"صفر", "واحد", "اثنان", "ثلاثة", "أربعة", "خمسة", "ستة", "سبعة", "ثمانية", "تسعة",
+		"عشرة", "أحد عشر", "اثنا عشر", "ثلاثة عشر", "أربعة عشر", "خمسة عشر", "ستة عشر", "سبعة عشر", "ثمانية عشر", "تسعة عشر"}
+	arTens     = [10]string{"", "", "عشرون", "ثلاثون", "أربعون", "خمسون", "ستون", "سبعون", "ثمانون", "تسعون"}
+	arHundreds = [10]string{"", "مائة", "مئتان", "ثلاثمائة", "أربعمائة", "خمسمائة", "ستمائة", "سبعمائة", "ثمانمائة", "تسعمائة"}
+	// scale nouns in the forms one, two, plural (3-10) and singular accusative (11-99)
+	arScales = [][5]string{{}, {"ألف", "ألفان", "آلاف", "ألفًا", "ألف"},
+		{"مليون", "مليونان", "ملايين", "مليونًا", "مليون"}, {"مليار", "ملياران", "مليارات", "مليارًا", "مليار"},
+		{"تريليون", "تريليونان", "تريليونات", "تريليونًا", "تريليون"},
+		{"كوادريليون", "كوادريليونان", "كوادريليونات", "كوادريليونًا", "كوادريليون"},
+		{"كوينتليون", "كوينتليونان", "كوينتليونات", "كوينتليونًا", "كوينتليون"}}
+)
+
+// spellARBelow1000 writes 0 < n < 1000 in arabic
+func spellARBelow1000(n uint64, g Gender) string {
+	var rest string
+	switch r := n % 100; {
+	case r == 0:
+	case r < 20:
+		rest = arOnes[r]
+	default:
+		rest = arTens[r/10]
+		if r%10 > 0 {
+			rest = arOnes[r%10] + " و" + rest
+		}
+	}
+	if g == Feminine {
+		switch n % 100 {
+		case 1:
+			rest = "واحدة"
+		case 2:
+			rest = "اثنتان"
+		}
+	}
+	return trimJoin(" و", arHundreds[n/100], rest)
+}
+
+func spellAR(n uint64, g Gender) string {
+	if n == 0 {
+		return arOnes[0]
+	}
+	groups := groupsOf1000(n)
+	var parts []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		switch {
+		case group == 0:
+		case i == 0:
+			parts = append(parts, spellARBelow1000(group, g))
+		case group == 1:
+			parts = append(parts, arScales[i][0])
+		case group == 2:
+			parts = append(parts, arScales[i][1])
+		case group <= 10:
+			parts = append(parts, spellARBelow1000(group, Masculine)+" "+arScales[i][2])
+		case group%100 >= 11:
+			parts = append(parts, spellARBelow1000(group, Masculine)+" "+arScales[i][3])
+		default:
+			parts = append(parts, spellARBelow1000(group, Masculine)+" "+arScales[i][4])
+		}
+	}
+	return strings.Join(parts, " و")
+}
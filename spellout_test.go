@@ -0,0 +1,137 @@
+package tinytranslator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSpellOut(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang string
+		n    int64
+		g    Gender
+		want string
+	}{
+		{"en", 0, Neutral, "zero"},
+		{"en", 120, Neutral, "one hundred twenty"},
+		{"en", 21, Neutral, "twenty-one"},
+		{"en", 1000001, Neutral, "one million one"},
+		{"en", -15, Neutral, "minus fifteen"},
+		{"en", math.MinInt64, Neutral, "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight"},
+		{"es", 120, Neutral, "ciento veinte"},
+		{"es", 100, Neutral, "cien"},
+		{"es", 21, Neutral, "veintiuno"},
+		{"es", 21000, Neutral, "veintiún mil"},
+		{"es", 201, Feminine, "doscientas una"},
+		{"es", 1000000, Neutral, "un millón"},
+		{"es", 2500000, Neutral, "dos millones quinientos mil"},
+		{"es", 1000000000, Neutral, "mil millones"},
+		{"es", 45, Neutral, "cuarenta y cinco"},
+		{"pt", 120, Neutral, "cento e vinte"},
+		{"pt", 1100, Neutral, "mil e cem"},
+		{"pt", 1120, Neutral, "mil cento e vinte"},
+		{"pt", 2, Feminine, "duas"},
+		{"pt", 2500000, Neutral, "dois milhões e quinhentos mil"},
+		{"fr", 80, Neutral, "quatre-vingts"},
+		{"fr", 81, Neutral, "quatre-vingt-un"},
+		{"fr", 71, Neutral, "soixante et onze"},
+		{"fr", 91, Neutral, "quatre-vingt-onze"},
+		{"fr", 21, Feminine, "vingt et une"},
+		{"fr", 200, Neutral, "deux cents"},
+		{"fr", 200000, Neutral, "deux cent mille"},
+		{"fr", 1001, Neutral, "mille un"},
+		{"de", 21, Neutral, "einundzwanzig"},
+		{"de", 101, Neutral, "einhunderteins"},
+		{"de", 1234, Neutral, "eintausendzweihundertvierunddreißig"},
+		{"de", 2000000, Neutral, "zwei Millionen"},
+		{"it", 21, Neutral, "ventuno"},
+		{"it", 23, Neutral, "ventitré"},
+		{"it", 2120, Neutral, "duemilacentoventi"},
+		{"it", 3, Neutral, "tre"},
+		{"it", 103, Neutral, "centotré"},
+		{"it", 1003, Neutral, "milletré"},
+		{"it", 3000, Neutral, "tremila"},
+		{"it", 180, Neutral, "centottanta"},
+		{"it", 288, Neutral, "duecentottantotto"},
+		{"it", 183, Neutral, "centottantatré"},
+		{"ru", 21, Neutral, "двадцать один"},
+		{"ru", 2, Feminine, "две"},
+		{"ru", 2000, Neutral, "две тысячи"},
+		{"ru", 5000, Neutral, "пять тысяч"},
+		{"ru", 1000000, Neutral, "один миллион"},
+		{"id", 11, Neutral, "sebelas"},
+		{"id", 1100, Neutral, "seribu seratus"},
+		{"id", 25, Neutral, "dua puluh lima"},
+		{"zh", 10, Neutral, "十"},
+		{"zh", 15, Neutral, "十五"},
+		{"zh", 105, Neutral, "一百零五"},
+		{"zh", 110, Neutral, "一百一十"},
+		{"zh", 1001, Neutral, "一千零一"},
+		{"zh", 100000, Neutral, "十万"},
+		{"zh", 10010, Neutral, "一万零一十"},
+		{"zh", 120000000, Neutral, "一亿二千万"},
+		{"hi", 120, Neutral, "एक सौ बीस"},
+		{"hi", 1234567, Neutral, "बारह लाख चौंतीस हज़ार पाँच सौ सड़सठ"},
+		{"ur", 45, Neutral, "پینتالیس"},
+		{"bn", 120, Neutral, "এক শত বিশ"},
+		{"ar", 21, Neutral, "واحد وعشرون"},
+		{"ar", 3000, Neutral, "ثلاثة آلاف"},
+		{"ar", 2, Feminine, "اثنتان"},
+		{"ar", 1120, Neutral, "ألف ومائة وعشرون"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.SpellOut(tt.lang, tt.n, tt.g); got != tt.want {
+			t.Errorf("SpellOut(%q, %d) = %q; want %q", tt.lang, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFormatOrdinal(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang string
+		n    int64
+		g    Gender
+		want string
+	}{
+		{"en", 1, Neutral, "1st"},
+		{"en", 2, Neutral, "2nd"},
+		{"en", 3, Neutral, "3rd"},
+		{"en", 11, Neutral, "11th"},
+		{"en", 22, Neutral, "22nd"},
+		{"en", 1001, Neutral, "1,001st"},
+		{"es", 1, Neutral, "1.º"},
+		{"es", 1, Feminine, "1.ª"},
+		{"pt", 2, Feminine, "2.ª"},
+		{"it", 3, Masculine, "3º"},
+		{"fr", 1, Neutral, "1er"},
+		{"fr", 1, Feminine, "1re"},
+		{"fr", 2, Neutral, "2e"},
+		{"de", 3, Neutral, "3."},
+		{"ru", 5, Feminine, "5-я"},
+		{"id", 2, Neutral, "ke-2"},
+		{"zh", 3, Neutral, "第3"},
+		{"hi", 4, Neutral, "4था"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.FormatOrdinal(tt.lang, tt.n, tt.g); got != tt.want {
+			t.Errorf("FormatOrdinal(%q, %d) = %q; want %q", tt.lang, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestOrdinalAndSpelledArguments(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	if got := translator.T("es", Ordinal{N: 1, Gender: Feminine}, D.Field); got != "1.ª campo" {
+		t.Errorf("T() = %q", got)
+	}
+	if got := translator.T("es", D.Value, ':', Spelled{N: 120}); got != "valor: ciento veinte" {
+		t.Errorf("T() = %q", got)
+	}
+}
@@ -0,0 +1,56 @@
+package tinytranslator
+
+import "strings"
+
+// LanguageStats reports how complete the translations of a language are
+type LanguageStats struct {
+	Code          string   // language code, eg: "es"
+	Total         int      // number of dictionary entries
+	Translated    int      // entries with a value in the language
+	Empty         int      // entries without value, they fall back to the default language
+	SameAsEnglish int      // translated entries equal to english ignoring case, eg: "test"
+	Completeness  float64  // percent of translated entries, from 0 to 100
+	Missing       []string // keys of the entries without value, in dictionary order
+}
+
+// Stats returns the completeness of every supported language in index order,
+// starting with English. Pseudo-locales are not listed.
+//
+// Example usage:
+//
+//	for _, s := range translator.Stats() {
+//		if s.Code == "ar" && s.Completeness < 95 {
+//			log.Fatalf("ar is %.1f%% translated, missing: %v", s.Completeness, s.Missing)
+//		}
+//	}
+func (l Translator) Stats() []LanguageStats {
+	stats := make([]LanguageStats, 0, len(l.langSupported))
+
+	for _, lang := range l.langSupported {
+		if isPseudoLocale(lang.Code) {
+			continue
+		}
+
+		s := LanguageStats{Code: lang.Code, Total: len(l.translations)}
+		for _, trans := range l.translations {
+			// Entries built before a namespace added languages have fewer values
+			if lang.Index >= len(trans.Values) || trans.Values[lang.Index] == "" {
+				s.Empty++
+				s.Missing = append(s.Missing, trans.Key)
+				continue
+			}
+			s.Translated++
+			if lang.Index > 0 && strings.EqualFold(trans.Values[lang.Index], trans.Values[0]) {
+				s.SameAsEnglish++
+			}
+		}
+
+		s.Completeness = 100
+		if s.Total > 0 {
+			s.Completeness = float64(s.Translated) * 100 / float64(s.Total)
+		}
+		stats = append(stats, s)
+	}
+
+	return stats
+}
@@ -0,0 +1,48 @@
+package tinytranslator
+
+import "testing"
+
+func TestStats(t *testing.T) {
+	translator := NewTranslationEngine().WithPseudoLocales()
+
+	stats := translator.Stats()
+	if len(stats) != len(translator.Languages())-2 {
+		t.Fatalf("Stats() lists %d languages; want all but the pseudo-locales", len(stats))
+	}
+
+	for _, s := range stats {
+		if s.Total != len(translator.Keys()) || s.Translated+s.Empty != s.Total {
+			t.Errorf("%s: inconsistent counts %+v", s.Code, s)
+		}
+		if s.Code == "en" && (s.Completeness != 100 || s.SameAsEnglish != 0) {
+			t.Errorf("en stats = %+v; want complete", s)
+		}
+	}
+
+	var form struct {
+		Nickname string `es:"apodo"`
+		Email    string `es:"Email" fr:"courriel"`
+	}
+	if err := translator.LoadNamespace("form", &form); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+
+	byCode := make(map[string]LanguageStats)
+	for _, s := range translator.Stats() {
+		byCode[s.Code] = s
+	}
+
+	es, fr := byCode["es"], byCode["fr"]
+	if es.Empty != 0 || es.Completeness != 100 {
+		t.Errorf("es stats = %+v; want complete", es)
+	}
+	if es.SameAsEnglish < 1 {
+		t.Errorf("es SameAsEnglish = %d; want form.email counted", es.SameAsEnglish)
+	}
+	if fr.Empty != 1 || len(fr.Missing) != 1 || fr.Missing[0] != "form.nickname" {
+		t.Errorf("fr stats = %+v; want form.nickname missing", fr)
+	}
+	if want := float64(fr.Total-1) * 100 / float64(fr.Total); fr.Completeness != want {
+		t.Errorf("fr Completeness = %v; want %v", fr.Completeness, want)
+	}
+}
@@ -1,10 +1,53 @@
 package tinytranslator
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// tagPair es una pareja clave:"valor" de un StructTag.
+type tagPair struct {
+	key   string
+	value string
+}
+
+// languageCodes are the ISO 639-1 codes and the ISO 639-2 codes of languages
+// without a two letter one, sorted. Only tags named after them are languages,
+// so other tags such as `json:"..."` or `bun:"..."` are ignored.
+var languageCodes = strings.Fields(`
+	aa ab ae af ak am an ar as ast av ay az ba bal ban be bem bg bh bho bi bm bn
+	bo br bs ca ce ceb ch chr co cr cs cu cv cy da de doi dsb dv dz ee el en eo
+	es et eu fa ff fi fil fj fo fr fy ga gd gl gn gsw gu gv ha haw he hi hil hmn
+	ho hr hsb ht hu hy hz ia id ie ig ii ik ilo io is it iu ja jv ka kab kg ki
+	kj kk kl km kn ko kok kr ks ku kv kw ky la lad lb lg li ln lo lt lu lv mad
+	mag mai mak mg mh mi min mk ml mn mni mr ms mt my na nap nb nd nds ne ng nl
+	nn no nr nso nv ny oc oj om or os pa pam pap pi pl ps pt qu rm rn ro ru rw
+	sa sah sat sc scn sco sd se sg shn si sk sl sm sn so sq sr ss st su sv sw
+	syr ta te tet tg th ti tk tl tn to tpi tr ts tt tum tw ty ug uk ur uz ve vi
+	vo wa war wo xh yi yo za zh zu
+`)
+
+// isLanguageTag reports whether a tag key is a known language code
+func isLanguageTag(key string) bool {
+	_, found := slices.BinarySearch(languageCodes, key)
+	return found
+}
 
 // parseTag extrae todas las parejas clave:"valor" de un StructTag sin usar regexp.
 func parseTag(tag reflect.StructTag) map[string]string {
-	result := make(map[string]string)
+	pairs := parseTagPairs(tag)
+	result := make(map[string]string, len(pairs))
+	for _, p := range pairs {
+		result[p.key] = p.value
+	}
+	return result
+}
+
+// parseTagPairs extrae las parejas clave:"valor" de un StructTag conservando
+// el orden en que fueron declaradas.
+func parseTagPairs(tag reflect.StructTag) []tagPair {
+	var result []tagPair
 	tagStr := string(tag)
 
 	// Estado del parser
@@ -52,7 +95,7 @@ func parseTag(tag reflect.StructTag) map[string]string {
 		}
 
 		value := tagStr[valueStart:i]
-		result[key] = value
+		result = append(result, tagPair{key: key, value: value})
 		i++ // Saltar comillas de cierre
 	}
 
@@ -2,6 +2,7 @@ package tinytranslator
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -39,3 +40,34 @@ func TestParseTag(t *testing.T) {
 		}
 	}
 }
+
+func TestParseTagPairsOrder(t *testing.T) {
+	pairs := parseTagPairs(`zh:"地址" es:"dirección" ar:"عنوان"`)
+	want := []string{"zh", "es", "ar"}
+
+	if len(pairs) != len(want) {
+		t.Fatalf("parseTagPairs returned %d pairs; want %d", len(pairs), len(want))
+	}
+	for i, p := range pairs {
+		if p.key != want[i] {
+			t.Errorf("pair %d key = %q; want %q", i, p.key, want[i])
+		}
+	}
+}
+
+func TestIsLanguageTag(t *testing.T) {
+	if !slices.IsSorted(languageCodes) {
+		t.Fatalf("languageCodes is not sorted")
+	}
+
+	for _, key := range []string{"en", "es", "zh", "ko", "fil", "haw"} {
+		if !isLanguageTag(key) {
+			t.Errorf("isLanguageTag(%q) = false; want true", key)
+		}
+	}
+	for _, key := range []string{"json", "validate", "db", "bun", "pg", "ini", "xml", "ctx", "key", "es_f", "ES", ""} {
+		if isLanguageTag(key) {
+			t.Errorf("isLanguageTag(%q) = true; want false", key)
+		}
+	}
+}
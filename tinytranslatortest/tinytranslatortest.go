@@ -0,0 +1,164 @@
+// Package tinytranslatortest provides helpers to test code that uses
+// tinytranslator: assertions on rendered translations and dictionary
+// coverage, golden-file snapshots of every translation and a writer that
+// records the output of Translator.Print.
+package tinytranslatortest
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cdvelop/tinytranslator"
+)
+
+// UpdateEnv is the environment variable that rewrites golden files when set,
+// eg: TINYTRANSLATOR_UPDATE=1 go test ./...
+const UpdateEnv = "TINYTRANSLATOR_UPDATE"
+
+// AssertTranslation fails the test when key does not render as want in lang.
+//
+// Example usage:
+//
+//	tinytranslatortest.AssertTranslation(t, translator, "es", D.Language, "idioma")
+func AssertTranslation(t testing.TB, tr *tinytranslator.Translator, lang, key, want string) {
+	t.Helper()
+	if got := tr.T(lang, key); got != want {
+		t.Errorf("%s %q = %q; want %q", lang, key, got, want)
+	}
+}
+
+// AssertComplete fails the test for every dictionary entry without a
+// translation in one of the given languages, all of them when none are given.
+//
+// Example usage:
+//
+//	tinytranslatortest.AssertComplete(t, translator, "es", "fr")
+func AssertComplete(t testing.TB, tr *tinytranslator.Translator, langs ...string) {
+	t.Helper()
+	for _, m := range tr.MissingTranslations() {
+		for _, code := range m.Languages {
+			if len(langs) == 0 || slices.Contains(langs, code) {
+				t.Errorf("%s: missing translation of %q", code, m.Key)
+			}
+		}
+	}
+}
+
+// AssertGolden compares every translation of the dictionary, one line per key
+// and language, with the golden file at path. The file is only written when the
+// UpdateEnv environment variable is set, a missing file fails the test.
+//
+// Example usage:
+//
+//	tinytranslatortest.AssertGolden(t, translator, "testdata/translations.golden")
+func AssertGolden(t testing.TB, tr *tinytranslator.Translator, path string) {
+	t.Helper()
+	got := Snapshot(tr)
+
+	if os.Getenv(UpdateEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("golden %s: %v", path, err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("golden %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		t.Fatalf("golden %s does not exist, set %s=1 to write it", path, UpdateEnv)
+	} else if err != nil {
+		t.Fatalf("golden %s: %v", path, err)
+	}
+
+	if got != string(want) {
+		gotLines, wantLines := strings.Split(got, "\n"), strings.Split(string(want), "\n")
+		for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+			var g, w string
+			if i < len(gotLines) {
+				g = gotLines[i]
+			}
+			if i < len(wantLines) {
+				w = wantLines[i]
+			}
+			if g != w {
+				t.Errorf("golden %s line %d:\n got: %q\nwant: %q\nset %s=1 to update", path, i+1, g, w, UpdateEnv)
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns every translation of the dictionary as "key lang: text"
+// lines, in dictionary and language order. Texts taken from the default
+// language because the entry has no translation are written as
+// "key lang (missing): text".
+func Snapshot(tr *tinytranslator.Translator) string {
+	missing := make(map[string][]string)
+	for _, m := range tr.MissingTranslations() {
+		missing[tinytranslator.Ctx(m.Context, m.Key)] = m.Languages
+	}
+
+	var b strings.Builder
+	for _, key := range tr.Keys() {
+		// Context qualified keys are written as "context|key"
+		name := key
+		if context, plain := tinytranslator.SplitCtx(key); context != "" {
+			name = context + "|" + plain
+		}
+		for _, lang := range tr.Languages() {
+			b.WriteString(name + " " + lang)
+			if slices.Contains(missing[key], lang) {
+				b.WriteString(" (missing)")
+			}
+			b.WriteString(": " + tr.T(lang, key) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// Writer records the messages written by Translator.Print, it is safe for
+// concurrent use.
+//
+// Example usage:
+//
+//	w := &tinytranslatortest.Writer{}
+//	translator := tinytranslator.NewTranslationEngine(w)
+//	translator.Print("es", D.Language)
+//	w.Messages() // ["idioma"]
+type Writer struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+// Write records p as a message
+func (w *Writer) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.messages = append(w.messages, string(p))
+	return len(p), nil
+}
+
+// Messages returns the recorded messages in order
+func (w *Writer) Messages() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string(nil), w.messages...)
+}
+
+// String returns the recorded messages joined by new lines
+func (w *Writer) String() string {
+	return strings.Join(w.Messages(), "\n")
+}
+
+// Reset discards the recorded messages
+func (w *Writer) Reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.messages = nil
+}
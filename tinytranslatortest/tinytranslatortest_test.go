@@ -0,0 +1,148 @@
+package tinytranslatortest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/cdvelop/tinytranslator"
+)
+
+// recorder is a testing.TB that records failures instead of failing
+type recorder struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	r.fatal = true
+}
+
+func TestAssertTranslation(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	AssertTranslation(t, translator, "es", D.Language, "idioma")
+
+	r := &recorder{TB: t}
+	AssertTranslation(r, translator, "es", D.Language, "lengua")
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], `"idioma"`) {
+		t.Errorf("AssertTranslation failures = %q; want one reporting the rendered value", r.errors)
+	}
+}
+
+func TestAssertComplete(t *testing.T) {
+	translator := NewTranslationEngine()
+	AssertComplete(t, translator)
+
+	var form struct {
+		Nickname string `es:"apodo"`
+	}
+	if err := translator.LoadNamespace("form", &form); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+
+	r := &recorder{TB: t}
+	AssertComplete(r, translator, "es", "fr")
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], "fr: missing translation of \"form.nickname\"") {
+		t.Errorf("AssertComplete failures = %q; want fr missing form.nickname", r.errors)
+	}
+
+	// A namespace adding a language leaves the entries loaded before it incomplete
+	var korean struct {
+		Greeting string `ko:"안녕하세요"`
+	}
+	if err := translator.LoadNamespace("korean", &korean); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+	r = &recorder{TB: t}
+	AssertComplete(r, translator, "ko")
+	if len(r.errors) != len(translator.Keys())-1 || !strings.Contains(r.errors[0], "ko: missing translation of") {
+		t.Errorf("AssertComplete(ko) reported %d failures; want %d", len(r.errors), len(translator.Keys())-1)
+	}
+	if !strings.Contains(Snapshot(translator), "language ko (missing): language\n") {
+		t.Errorf("Snapshot does not mark the missing ko translation of language")
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	translator := NewTranslationEngine()
+	path := filepath.Join(t.TempDir(), "testdata", "translations.golden")
+
+	var form struct {
+		Nickname string `es:"apodo"`
+	}
+	if err := translator.LoadNamespace("form", &form); err != nil {
+		t.Fatalf("LoadNamespace error: %v", err)
+	}
+
+	// A missing golden file fails instead of being written
+	r := &recorder{TB: t}
+	AssertGolden(r, translator, path)
+	if !r.fatal {
+		t.Errorf("AssertGolden with a missing file did not fail")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("golden file written without %s: %v", UpdateEnv, err)
+	}
+
+	t.Setenv(UpdateEnv, "1")
+	AssertGolden(t, translator, path)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("golden file not written: %v", err)
+	}
+	for _, line := range []string{"language es: idioma\n", "char|space ru: пробел\n", "form.nickname es: apodo\n", "form.nickname fr (missing): nickname\n"} {
+		if !strings.Contains(string(data), line) {
+			t.Errorf("snapshot misses the line %q", line)
+		}
+	}
+
+	t.Setenv(UpdateEnv, "")
+	AssertGolden(t, translator, path)
+
+	changed := strings.Replace(string(data), "language es: idioma", "language es: lengua", 1)
+	if err := os.WriteFile(path, []byte(changed), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	r = &recorder{TB: t}
+	AssertGolden(r, translator, path)
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], "lengua") {
+		t.Errorf("AssertGolden failures = %q; want the changed line", r.errors)
+	}
+
+	t.Setenv(UpdateEnv, "1")
+	AssertGolden(t, translator, path)
+	if data, _ := os.ReadFile(path); string(data) != Snapshot(translator) {
+		t.Errorf("golden file not updated with %s set", UpdateEnv)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	w := &Writer{}
+	translator := NewTranslationEngine(w)
+
+	translator.Print("es", D.Language)
+	translator.Print("fr", D.Language)
+
+	if got := w.Messages(); len(got) != 2 || got[0] != "idioma" || got[1] != "langue" {
+		t.Errorf("Messages() = %q; want [idioma langue]", got)
+	}
+	if got := w.String(); got != "idioma\nlangue" {
+		t.Errorf("String() = %q", got)
+	}
+
+	w.Reset()
+	if got := w.Messages(); len(got) != 0 {
+		t.Errorf("Messages() after Reset = %q", got)
+	}
+}
@@ -0,0 +1,387 @@
+package tinytranslator
+
+import (
+	"math"
+	"strings"
+)
+
+// Unit is a unit of measurement written by FormatMeasure
+type Unit int
+
+const (
+	Byte Unit = iota
+	Kilobyte
+	Megabyte
+	Gigabyte
+	Terabyte
+	Kibibyte
+	Mebibyte
+	Gibibyte
+	Tebibyte
+	Meter
+	Kilometer
+	Centimeter
+	Mile
+	Foot
+	Inch
+	Kilogram
+	Gram
+	Pound
+	Liter
+	Celsius
+	Fahrenheit
+)
+
+// UnitStyle selects between unit symbols and unit names
+type UnitStyle int
+
+const (
+	UnitShort UnitStyle = iota // eg: "3 km"
+	UnitLong                   // eg: "3 kilometers"
+)
+
+// MeasurementSystem is the preferred system of units of a translator
+type MeasurementSystem int
+
+const (
+	AsGiven  MeasurementSystem = iota // units are written as given
+	Metric                            // imperial units are converted to metric
+	Imperial                          // metric units are converted to imperial
+)
+
+// Measure wraps a value and its unit so T() writes it with the unit name.
+//
+// Example usage:
+//
+//	translator.T("es", D.Value, ':', Measure{Value: 3, Unit: Kilometer, Style: UnitLong}) // "valor: 3 kilómetros"
+type Measure struct {
+	Value float64
+	Unit  Unit
+	Style UnitStyle
+}
+
+// ByteSize wraps an amount of bytes so T() writes it with the largest fitting
+// prefix, decimal (kB, MB) or binary (KiB, MiB).
+//
+// Example usage:
+//
+//	translator.T("en", ByteSize{N: 1536, Binary: true}) // "1.5 KiB"
+type ByteSize struct {
+	N      int64
+	Binary bool
+}
+
+// unitNames holds the names of the units in a language
+type unitNames struct {
+	byteWords [9]string       // long names of Byte ... Tebibyte, eg: "kilobyte"
+	byteForms PluralForms     // long forms of the byte units, "{u}" is the byte word
+	long      [12]PluralForms // long forms of Meter ... Fahrenheit
+	short     map[Unit]string // short forms that differ from the english ones
+}
+
+// shortUnits are the symbols of the units used by default
+var shortUnits = [...]string{
+	Byte: "{0} B", Kilobyte: "{0} kB", Megabyte: "{0} MB", Gigabyte: "{0} GB", Terabyte: "{0} TB",
+	Kibibyte: "{0} KiB", Mebibyte: "{0} MiB", Gibibyte: "{0} GiB", Tebibyte: "{0} TiB",
+	Meter: "{0} m", Kilometer: "{0} km", Centimeter: "{0} cm", Mile: "{0} mi", Foot: "{0} ft", Inch: "{0} in",
+	Kilogram: "{0} kg", Gram: "{0} g", Pound: "{0} lb", Liter: "{0} L", Celsius: "{0} °C", Fahrenheit: "{0} °F",
+}
+
+// latinByteWords are the byte unit names shared by languages in latin script
+var latinByteWords = [9]string{"byte", "kilobyte", "megabyte", "gigabyte", "terabyte", "kibibyte", "mebibyte", "gibibyte", "tebibyte"}
+
+// same returns plural forms that do not change with the number
+func same(form string) PluralForms {
+	return PluralForms{PluralOther: form}
+}
+
+// ruForms returns russian plural forms, fractions use the few form
+func ruForms(one, few, many string) PluralForms {
+	return PluralForms{PluralOne: one, PluralFew: few, PluralMany: many, PluralOther: few}
+}
+
+// arForms returns arabic forms with the plural used from 3 to 10
+func arForms(singular, plural string) PluralForms {
+	return PluralForms{PluralFew: plural, PluralOther: singular}
+}
+
+var units = map[string]unitNames{
+	"en": {
+		byteWords: latinByteWords, byteForms: pf("{0} {u}", "{0} {u}s"),
+		long: [12]PluralForms{
+			pf("{0} meter", "{0} meters"), pf("{0} kilometer", "{0} kilometers"), pf("{0} centimeter", "{0} centimeters"),
+			pf("{0} mile", "{0} miles"), pf("{0} foot", "{0} feet"), pf("{0} inch", "{0} inches"),
+			pf("{0} kilogram", "{0} kilograms"), pf("{0} gram", "{0} grams"), pf("{0} pound", "{0} pounds"),
+			pf("{0} liter", "{0} liters"), pf("{0} degree Celsius", "{0} degrees Celsius"), pf("{0} degree Fahrenheit", "{0} degrees Fahrenheit"),
+		},
+		short: map[Unit]string{Celsius: "{0}°C", Fahrenheit: "{0}°F"},
+	},
+	"es": {
+		byteWords: latinByteWords, byteForms: pf("{0} {u}", "{0} {u}s"),
+		long: [12]PluralForms{
+			pf("{0} metro", "{0} metros"), pf("{0} kilómetro", "{0} kilómetros"), pf("{0} centímetro", "{0} centímetros"),
+			pf("{0} milla", "{0} millas"), pf("{0} pie", "{0} pies"), pf("{0} pulgada", "{0} pulgadas"),
+			pf("{0} kilogramo", "{0} kilogramos"), pf("{0} gramo", "{0} gramos"), pf("{0} libra", "{0} libras"),
+			pf("{0} litro", "{0} litros"), pf("{0} grado Celsius", "{0} grados Celsius"), pf("{0} grado Fahrenheit", "{0} grados Fahrenheit"),
+		},
+	},
+	"pt": {
+		byteWords: latinByteWords, byteForms: pf("{0} {u}", "{0} {u}s"),
+		long: [12]PluralForms{
+			pf("{0} metro", "{0} metros"), pf("{0} quilômetro", "{0} quilômetros"), pf("{0} centímetro", "{0} centímetros"),
+			pf("{0} milha", "{0} milhas"), pf("{0} pé", "{0} pés"), pf("{0} polegada", "{0} polegadas"),
+			pf("{0} quilograma", "{0} quilogramas"), pf("{0} grama", "{0} gramas"), pf("{0} libra", "{0} libras"),
+			pf("{0} litro", "{0} litros"), pf("{0} grau Celsius", "{0} graus Celsius"), pf("{0} grau Fahrenheit", "{0} graus Fahrenheit"),
+		},
+	},
+	"fr": {
+		byteWords: [9]string{"octet", "kilooctet", "mégaoctet", "gigaoctet", "téraoctet", "kibioctet", "mébioctet", "gibioctet", "tébioctet"},
+		byteForms: pf("{0} {u}", "{0} {u}s"),
+		long: [12]PluralForms{
+			pf("{0} mètre", "{0} mètres"), pf("{0} kilomètre", "{0} kilomètres"), pf("{0} centimètre", "{0} centimètres"),
+			pf("{0} mile", "{0} miles"), pf("{0} pied", "{0} pieds"), pf("{0} pouce", "{0} pouces"),
+			pf("{0} kilogramme", "{0} kilogrammes"), pf("{0} gramme", "{0} grammes"), pf("{0} livre", "{0} livres"),
+			pf("{0} litre", "{0} litres"), pf("{0} degré Celsius", "{0} degrés Celsius"), pf("{0} degré Fahrenheit", "{0} degrés Fahrenheit"),
+		},
+		short: map[Unit]string{
+			Byte: "{0} o", Kilobyte: "{0} ko", Megabyte: "{0} Mo", Gigabyte: "{0} Go", Terabyte: "{0} To",
+			Kibibyte: "{0} Kio", Mebibyte: "{0} Mio", Gibibyte: "{0} Gio", Tebibyte: "{0} Tio",
+		},
+	},
+	"ru": {
+		byteWords: [9]string{"байт", "килобайт", "мегабайт", "гигабайт", "терабайт", "кибибайт", "мебибайт", "гибибайт", "тебибайт"},
+		byteForms: ruForms("{0} {u}", "{0} {u}а", "{0} {u}"),
+		long: [12]PluralForms{
+			ruForms("{0} метр", "{0} метра", "{0} метров"), ruForms("{0} километр", "{0} километра", "{0} километров"),
+			ruForms("{0} сантиметр", "{0} сантиметра", "{0} сантиметров"), ruForms("{0} миля", "{0} мили", "{0} миль"),
+			ruForms("{0} фут", "{0} фута", "{0} футов"), ruForms("{0} дюйм", "{0} дюйма", "{0} дюймов"),
+			ruForms("{0} килограмм", "{0} килограмма", "{0} килограммов"), ruForms("{0} грамм", "{0} грамма", "{0} граммов"),
+			ruForms("{0} фунт", "{0} фунта", "{0} фунтов"), ruForms("{0} литр", "{0} литра", "{0} литров"),
+			ruForms("{0} градус Цельсия", "{0} градуса Цельсия", "{0} градусов Цельсия"),
+			ruForms("{0} градус Фаренгейта", "{0} градуса Фаренгейта", "{0} градусов Фаренгейта"),
+		},
+		short: map[Unit]string{
+			Byte: "{0} Б", Kilobyte: "{0} кБ", Megabyte: "{0} МБ", Gigabyte: "{0} ГБ", Terabyte: "{0} ТБ",
+			Kibibyte: "{0} КиБ", Mebibyte: "{0} МиБ", Gibibyte: "{0} ГиБ", Tebibyte: "{0} ТиБ",
+			Meter: "{0} м", Kilometer: "{0} км", Centimeter: "{0} см", Mile: "{0} ми", Foot: "{0} фт", Inch: "{0} дюйм.",
+			Kilogram: "{0} кг", Gram: "{0} г", Pound: "{0} фнт", Liter: "{0} л",
+		},
+	},
+	"de": {
+		byteWords: [9]string{"Byte", "Kilobyte", "Megabyte", "Gigabyte", "Terabyte", "Kibibyte", "Mebibyte", "Gibibyte", "Tebibyte"},
+		byteForms: same("{0} {u}"),
+		long: [12]PluralForms{
+			same("{0} Meter"), same("{0} Kilometer"), same("{0} Zentimeter"),
+			pf("{0} Meile", "{0} Meilen"), same("{0} Fuß"), same("{0} Zoll"),
+			same("{0} Kilogramm"), same("{0} Gramm"), same("{0} Pfund"),
+			same("{0} Liter"), same("{0} Grad Celsius"), same("{0} Grad Fahrenheit"),
+		},
+	},
+	"it": {
+		byteWords: latinByteWords, byteForms: same("{0} {u}"),
+		long: [12]PluralForms{
+			pf("{0} metro", "{0} metri"), pf("{0} chilometro", "{0} chilometri"), pf("{0} centimetro", "{0} centimetri"),
+			pf("{0} miglio", "{0} miglia"), pf("{0} piede", "{0} piedi"), pf("{0} pollice", "{0} pollici"),
+			pf("{0} chilogrammo", "{0} chilogrammi"), pf("{0} grammo", "{0} grammi"), pf("{0} libbra", "{0} libbre"),
+			pf("{0} litro", "{0} litri"), pf("{0} grado Celsius", "{0} gradi Celsius"), pf("{0} grado Fahrenheit", "{0} gradi Fahrenheit"),
+		},
+	},
+	"hi": {
+		byteWords: [9]string{"बाइट", "किलोबाइट", "मेगाबाइट", "गीगाबाइट", "टेराबाइट", "किबिबाइट", "मेबिबाइट", "गिबिबाइट", "टेबिबाइट"},
+		byteForms: same("{0} {u}"),
+		long: [12]PluralForms{
+			same("{0} मीटर"), same("{0} किलोमीटर"), same("{0} सेंटीमीटर"),
+			same("{0} मील"), same("{0} फ़ुट"), same("{0} इंच"),
+			same("{0} किलोग्राम"), same("{0} ग्राम"), same("{0} पाउंड"),
+			same("{0} लीटर"), same("{0} डिग्री सेल्सियस"), same("{0} डिग्री फ़ारेनहाइट"),
+		},
+		short: map[Unit]string{Celsius: "{0}°C", Fahrenheit: "{0}°F"},
+	},
+	"bn": {
+		byteWords: [9]string{"বাইট", "কিলোবাইট", "মেগাবাইট", "গিগাবাইট", "টেরাবাইট", "কিবিবাইট", "মেবিবাইট", "গিবিবাইট", "টেবিবাইট"},
+		byteForms: same("{0} {u}"),
+		long: [12]PluralForms{
+			same("{0} মিটার"), same("{0} কিলোমিটার"), same("{0} সেন্টিমিটার"),
+			same("{0} মাইল"), same("{0} ফুট"), same("{0} ইঞ্চি"),
+			same("{0} কিলোগ্রাম"), same("{0} গ্রাম"), same("{0} পাউন্ড"),
+			same("{0} লিটার"), same("{0} ডিগ্রি সেলসিয়াস"), same("{0} ডিগ্রি ফারেনহাইট"),
+		},
+		short: map[Unit]string{Celsius: "{0}°C", Fahrenheit: "{0}°F"},
+	},
+	"id": {
+		byteWords: latinByteWords, byteForms: same("{0} {u}"),
+		long: [12]PluralForms{
+			same("{0} meter"), same("{0} kilometer"), same("{0} sentimeter"),
+			same("{0} mil"), same("{0} kaki"), same("{0} inci"),
+			same("{0} kilogram"), same("{0} gram"), same("{0} pon"),
+			same("{0} liter"), same("{0} derajat Celsius"), same("{0} derajat Fahrenheit"),
+		},
+	},
+	"ar": {
+		byteWords: [9]string{"بايت", "كيلوبايت", "ميغابايت", "غيغابايت", "تيرابايت", "كيبيبايت", "ميبيبايت", "جيبيبايت", "تيبيبايت"},
+		byteForms: same("{0} {u}"),
+		long: [12]PluralForms{
+			arForms("{0} متر", "{0} أمتار"), arForms("{0} كيلومتر", "{0} كيلومترات"), arForms("{0} سنتيمتر", "{0} سنتيمترات"),
+			arForms("{0} ميل", "{0} أميال"), arForms("{0} قدم", "{0} أقدام"), arForms("{0} بوصة", "{0} بوصات"),
+			arForms("{0} كيلوغرام", "{0} كيلوغرامات"), arForms("{0} غرام", "{0} غرامات"), arForms("{0} رطل", "{0} أرطال"),
+			arForms("{0} لتر", "{0} لترات"), arForms("{0} درجة مئوية", "{0} درجات مئوية"), arForms("{0} درجة فهرنهايت", "{0} درجات فهرنهايت"),
+		},
+		short: map[Unit]string{
+			Meter: "{0} م", Kilometer: "{0} كم", Centimeter: "{0} سم", Mile: "{0} ميل", Foot: "{0} قدم", Inch: "{0} بوصة",
+			Kilogram: "{0} كغ", Gram: "{0} غ", Pound: "{0} رطل", Liter: "{0} لتر",
+		},
+	},
+	"ur": {
+		byteWords: [9]string{"بائٹ", "کلوبائٹ", "میگابائٹ", "گیگابائٹ", "ٹیرابائٹ", "کبی بائٹ", "میبی بائٹ", "گبی بائٹ", "ٹیبی بائٹ"},
+		byteForms: same("{0} {u}"),
+		long: [12]PluralForms{
+			same("{0} میٹر"), same("{0} کلومیٹر"), same("{0} سینٹی میٹر"),
+			same("{0} میل"), same("{0} فٹ"), same("{0} انچ"),
+			same("{0} کلوگرام"), same("{0} گرام"), same("{0} پاؤنڈ"),
+			same("{0} لیٹر"), same("{0} ڈگری سیلسیس"), same("{0} ڈگری فارن ہائیٹ"),
+		},
+	},
+	"zh": {
+		byteWords: [9]string{"字节", "千字节", "兆字节", "吉字节", "太字节", "千位二进制字节", "兆位二进制字节", "吉位二进制字节", "太位二进制字节"},
+		byteForms: same("{0}{u}"),
+		long: [12]PluralForms{
+			same("{0}米"), same("{0}公里"), same("{0}厘米"),
+			same("{0}英里"), same("{0}英尺"), same("{0}英寸"),
+			same("{0}公斤"), same("{0}克"), same("{0}磅"),
+			same("{0}升"), same("{0}摄氏度"), same("{0}华氏度"),
+		},
+		short: map[Unit]string{
+			Meter: "{0}米", Kilometer: "{0}公里", Centimeter: "{0}厘米", Mile: "{0}英里", Foot: "{0}英尺", Inch: "{0}英寸",
+			Kilogram: "{0}公斤", Gram: "{0}克", Pound: "{0}磅", Liter: "{0}升", Celsius: "{0}°C", Fahrenheit: "{0}°F",
+		},
+	},
+}
+
+// unitConversions pairs each metric unit with its imperial equivalent
+var unitConversions = []struct {
+	metric, imperial Unit
+	toImperial       func(float64) float64
+	toMetric         func(float64) float64
+}{
+	{Kilometer, Mile, func(v float64) float64 { return v / 1.609344 }, func(v float64) float64 { return v * 1.609344 }},
+	{Meter, Foot, func(v float64) float64 { return v / 0.3048 }, func(v float64) float64 { return v * 0.3048 }},
+	{Centimeter, Inch, func(v float64) float64 { return v / 2.54 }, func(v float64) float64 { return v * 2.54 }},
+	{Kilogram, Pound, func(v float64) float64 { return v / 0.45359237 }, func(v float64) float64 { return v * 0.45359237 }},
+	{Celsius, Fahrenheit, func(v float64) float64 { return v*9/5 + 32 }, func(v float64) float64 { return (v - 32) * 5 / 9 }},
+}
+
+// imperialRegions are the regions that use the imperial system
+var imperialRegions = []string{"US", "LR", "MM"}
+
+// MeasurementSystemForRegion returns the measurement system used in an
+// ISO 3166 region code, eg: Imperial for "US" and Metric for "ES".
+func MeasurementSystemForRegion(region string) MeasurementSystem {
+	region = strings.ToUpper(region)
+	for _, r := range imperialRegions {
+		if r == region {
+			return Imperial
+		}
+	}
+	return Metric
+}
+
+// WithMeasurementSystem sets the preferred system of units. Measures given in
+// the other system are converted and rounded to one decimal.
+//
+// Example usage:
+//
+//	translator := NewTranslationEngine().WithMeasurementSystem(MeasurementSystemForRegion("US"))
+//	translator.FormatMeasure("en", 10, Kilometer, UnitLong) // "6.2 miles"
+func (l *Translator) WithMeasurementSystem(system MeasurementSystem) *Translator {
+	l.measurement = system
+	return l
+}
+
+// FormatMeasure writes a value with its unit in the given language, converting
+// it to the preferred measurement system if one was set. The number follows the
+// number format of the language. An empty or unsupported language uses the
+// default language.
+//
+// Example usage:
+//
+//	translator.FormatMeasure("en", 1.5, Megabyte, UnitShort) // "1.5 MB"
+//	translator.FormatMeasure("es", 3, Kilometer, UnitLong)   // "3 kilómetros"
+//	translator.FormatMeasure("de", 25, Celsius, UnitShort)   // "25 °C"
+//	translator.FormatMeasure("ru", 5, Kilometer, UnitLong)   // "5 километров"
+func (l Translator) FormatMeasure(lang string, value float64, unit Unit, style UnitStyle) string {
+	return l.formatMeasure(l.langIndex(lang), value, unit, style)
+}
+
+// FormatBytes writes an amount of bytes with the largest prefix that keeps the
+// value above one, rounded to one decimal. Binary prefixes are powers of 1024
+// (KiB, MiB), decimal ones powers of 1000 (kB, MB).
+//
+// Example usage:
+//
+//	translator.FormatBytes("en", 1500000, false) // "1.5 MB"
+//	translator.FormatBytes("fr", 1536, true)     // "1,5 Kio"
+func (l Translator) FormatBytes(lang string, n int64, binary bool) string {
+	return l.formatBytes(l.langIndex(lang), n, binary)
+}
+
+// formatBytes writes an amount of bytes in the language at idx
+func (l *Translator) formatBytes(idx int, n int64, binary bool) string {
+	base, prefixes := 1000.0, []Unit{Byte, Kilobyte, Megabyte, Gigabyte, Terabyte}
+	if binary {
+		base, prefixes = 1024.0, []Unit{Byte, Kibibyte, Mebibyte, Gibibyte, Tebibyte}
+	}
+
+	value := float64(n)
+	unit := prefixes[0]
+	for _, prefix := range prefixes[1:] {
+		// compare the rounded value so 999950 B moves up to "1 MB" instead of "1,000 kB"
+		if math.Abs(math.Round(value*10)/10) < base {
+			break
+		}
+		value /= base
+		unit = prefix
+	}
+	return l.writeMeasure(idx, math.Round(value*10)/10, unit, UnitShort)
+}
+
+// formatMeasure converts a value to the preferred system and writes it in the language at idx
+func (l *Translator) formatMeasure(idx int, value float64, unit Unit, style UnitStyle) string {
+	for _, c := range unitConversions {
+		switch {
+		case l.measurement == Imperial && unit == c.metric:
+			value, unit = math.Round(c.toImperial(value)*10)/10, c.imperial
+		case l.measurement == Metric && unit == c.imperial:
+			value, unit = math.Round(c.toMetric(value)*10)/10, c.metric
+		}
+	}
+	return l.writeMeasure(idx, value, unit, style)
+}
+
+// writeMeasure writes a value with its unit in the language at idx.
+// Unknown units write the bare number.
+func (l *Translator) writeMeasure(idx int, value float64, unit Unit, style UnitStyle) string {
+	conv := l.langSupported[idx]
+	if unit < Byte || unit > Fahrenheit {
+		return conv.number.formatFloat(value)
+	}
+	names, ok := units[conv.Code]
+	if !ok {
+		names = units["en"]
+	}
+
+	var pattern string
+	switch {
+	case style == UnitLong && unit <= Tebibyte:
+		form := names.byteForms.form(Plural(conv.Code, value))
+		pattern = strings.ReplaceAll(form, "{u}", names.byteWords[unit])
+	case style == UnitLong:
+		pattern = names.long[unit-Meter].form(Plural(conv.Code, value))
+	default:
+		pattern = names.short[unit]
+		if pattern == "" {
+			pattern = shortUnits[unit]
+		}
+	}
+
+	return strings.ReplaceAll(pattern, "{0}", conv.number.formatFloat(value))
+}
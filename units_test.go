@@ -0,0 +1,118 @@
+package tinytranslator
+
+import "testing"
+
+func TestFormatMeasure(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang  string
+		value float64
+		unit  Unit
+		style UnitStyle
+		want  string
+	}{
+		{"en", 1.5, Megabyte, UnitShort, "1.5 MB"},
+		{"en", 1, Kilometer, UnitLong, "1 kilometer"},
+		{"en", 3, Foot, UnitLong, "3 feet"},
+		{"en", 21, Celsius, UnitShort, "21°C"},
+		{"de", 25, Celsius, UnitShort, "25 °C"},
+		{"de", 2, Mile, UnitLong, "2 Meilen"},
+		{"es", 3, Kilometer, UnitLong, "3 kilómetros"},
+		{"es", 2.5, Liter, UnitShort, "2,5 L"},
+		{"fr", 1, Kilobyte, UnitLong, "1 kilooctet"},
+		{"fr", 2, Gigabyte, UnitShort, "2 Go"},
+		{"ru", 1, Kilometer, UnitLong, "1 километр"},
+		{"ru", 3, Kilometer, UnitLong, "3 километра"},
+		{"ru", 5, Kilometer, UnitLong, "5 километров"},
+		{"ru", 2, Megabyte, UnitLong, "2 мегабайта"},
+		{"ru", 1.5, Kilogram, UnitLong, "1,5 килограмма"},
+		{"it", 2, Mile, UnitLong, "2 miglia"},
+		{"zh", 5, Kilometer, UnitLong, "5公里"},
+		{"ar", 3, Meter, UnitLong, "3 أمتار"},
+		{"xx", 1, Gram, UnitLong, "1 gram"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.FormatMeasure(tt.lang, tt.value, tt.unit, tt.style); got != tt.want {
+			t.Errorf("FormatMeasure(%q, %v, %v, %v) = %q; want %q", tt.lang, tt.value, tt.unit, tt.style, got, tt.want)
+		}
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	tests := []struct {
+		lang   string
+		n      int64
+		binary bool
+		want   string
+	}{
+		{"en", 512, false, "512 B"},
+		{"en", 1500000, false, "1.5 MB"},
+		{"en", 1536, true, "1.5 KiB"},
+		{"en", 1000, true, "1,000 B"},
+		{"en", 3 << 40, true, "3 TiB"},
+		{"fr", 1536, true, "1,5 Kio"},
+		{"ru", 2000000000, false, "2 ГБ"},
+		{"de", 1250000, false, "1,3 MB"},
+		{"en", 999950, false, "1 MB"},
+		{"en", 999949, false, "999.9 kB"},
+		{"en", 1048575, true, "1 MiB"},
+	}
+
+	for _, tt := range tests {
+		if got := translator.FormatBytes(tt.lang, tt.n, tt.binary); got != tt.want {
+			t.Errorf("FormatBytes(%q, %d, %v) = %q; want %q", tt.lang, tt.n, tt.binary, got, tt.want)
+		}
+	}
+}
+
+func TestMeasurementSystem(t *testing.T) {
+	if got := MeasurementSystemForRegion("us"); got != Imperial {
+		t.Errorf("MeasurementSystemForRegion(us) = %v; want Imperial", got)
+	}
+	if got := MeasurementSystemForRegion("ES"); got != Metric {
+		t.Errorf("MeasurementSystemForRegion(ES) = %v; want Metric", got)
+	}
+
+	imperial := NewTranslationEngine().WithMeasurementSystem(Imperial)
+	if got := imperial.FormatMeasure("en", 10, Kilometer, UnitLong); got != "6.2 miles" {
+		t.Errorf("imperial 10 km = %q; want %q", got, "6.2 miles")
+	}
+	if got := imperial.FormatMeasure("en", 100, Celsius, UnitShort); got != "212°F" {
+		t.Errorf("imperial 100 °C = %q; want %q", got, "212°F")
+	}
+
+	metric := NewTranslationEngine().WithMeasurementSystem(Metric)
+	if got := metric.FormatMeasure("es", 10, Pound, UnitLong); got != "4,5 kilogramos" {
+		t.Errorf("metric 10 lb = %q; want %q", got, "4,5 kilogramos")
+	}
+
+	// Units without equivalent are kept
+	if got := metric.FormatMeasure("en", 2, Gram, UnitShort); got != "2 g" {
+		t.Errorf("metric 2 g = %q; want %q", got, "2 g")
+	}
+}
+
+func TestMeasureArguments(t *testing.T) {
+	translator := NewTranslationEngine()
+
+	got := translator.T("es", D.Value, ':', Measure{Value: 3, Unit: Kilometer, Style: UnitLong})
+	if want := "valor: 3 kilómetros"; got != want {
+		t.Errorf("T(Measure) = %q; want %q", got, want)
+	}
+
+	got = translator.T("en", D.Value, ':', ByteSize{N: 1536, Binary: true})
+	if want := "value: 1.5 KiB"; got != want {
+		t.Errorf("T(ByteSize) = %q; want %q", got, want)
+	}
+
+	for _, style := range []UnitStyle{UnitShort, UnitLong} {
+		got = translator.T("en", D.Value, ':', Measure{Value: 7, Unit: Unit(99), Style: style})
+		if want := "value: 7"; got != want {
+			t.Errorf("T(Measure) with unknown unit = %q; want %q", got, want)
+		}
+	}
+}